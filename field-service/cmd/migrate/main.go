@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/config"
+	"github.com/DevisArya/learn-microservices/field-service/internal/migration"
+	migrationkit "github.com/DevisArya/learn-microservices/svckit/migration"
+)
+
+func main() {
+
+	command, err := migrationkit.ParseCommand(os.Args[1:])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, migrationkit.Usage)
+		os.Exit(2)
+	}
+
+	cfg, err := config.LoadSettings()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
+	migrator, err := migration.NewMigrator(config.NewDB(&cfg.Config))
+	if err != nil {
+		log.Fatalf("failed to load migrations: %v", err)
+	}
+
+	if err := command.Run(context.Background(), migrator, os.Stdout); err != nil {
+		log.Fatalf("migrate %s: %v", command.Name, err)
+	}
+}
@@ -4,13 +4,17 @@ go 1.23.5
 
 require (
 	github.com/DevisArya/learn-microservices-protorepo v1.0.2
+	github.com/DevisArya/learn-microservices/svcconfig v0.0.0
+	github.com/DevisArya/learn-microservices/svckit v0.0.0
 	github.com/go-playground/validator/v10 v10.26.0
 	google.golang.org/grpc v1.71.1
 	gorm.io/driver/mysql v1.5.7
+	gorm.io/driver/sqlite v1.5.7
 	gorm.io/gorm v1.25.12
 )
 
 require (
+	github.com/BurntSushi/toml v1.5.0 // indirect
 	github.com/gabriel-vasile/mimetype v1.4.8 // indirect
 	github.com/go-playground/locales v0.14.1 // indirect
 	github.com/go-playground/universal-translator v0.18.1 // indirect
@@ -18,10 +22,18 @@ require (
 	github.com/jinzhu/inflection v1.0.0 // indirect
 	github.com/jinzhu/now v1.1.5 // indirect
 	github.com/leodido/go-urn v1.4.0 // indirect
+	github.com/mattn/go-sqlite3 v1.14.22 // indirect
 	golang.org/x/crypto v0.33.0 // indirect
 	golang.org/x/net v0.34.0 // indirect
 	golang.org/x/sys v0.30.0 // indirect
 	golang.org/x/text v0.22.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250115164207-1a7da9e5054f // indirect
 	google.golang.org/protobuf v1.36.4 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/DevisArya/learn-microservices-protorepo => ../protorepo
+
+replace github.com/DevisArya/learn-microservices/svcconfig => ../svcconfig
+
+replace github.com/DevisArya/learn-microservices/svckit => ../svckit
@@ -0,0 +1,223 @@
+package broadcast
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+)
+
+var (
+	// ErrSlowConsumer closes a subscription that fell a full buffer behind.
+	// The subscriber may resume from the last event it received.
+	ErrSlowConsumer = errors.New("subscriber is too slow, resume from the last received event")
+
+	// ErrResumeUnavailable is returned when the events after a resume token
+	// are no longer kept, e.g. after a restart; the subscriber has to reload
+	// the schedules and watch again without a token.
+	ErrResumeUnavailable = errors.New("resume token expired, reload schedules")
+)
+
+// ScheduleBroadcaster fans out schedule status changes to the subscribers
+// watching the field and date range of the slot. It lives in process, so a
+// subscriber only sees changes made through the same replica.
+type ScheduleBroadcaster interface {
+	Publish(event dto.ScheduleEvent)
+	Subscribe(filter *dto.ScheduleFilter, resumeToken string) (*ScheduleSubscription, error)
+	Unsubscribe(subscription *ScheduleSubscription)
+	ResumeToken(event *dto.ScheduleEvent) string
+}
+
+// ScheduleSubscription delivers matching events on Events until it is
+// unsubscribed or dropped; Err tells why Events was closed.
+type ScheduleSubscription struct {
+	Events <-chan dto.ScheduleEvent
+
+	events chan dto.ScheduleEvent
+	filter dto.ScheduleFilter
+	err    error
+}
+
+// Err returns the reason the subscription was closed, nil when it was
+// unsubscribed.
+func (subscription *ScheduleSubscription) Err() error {
+	return subscription.err
+}
+
+func (subscription *ScheduleSubscription) matches(event *dto.ScheduleEvent) bool {
+	date := event.Schedule.Date
+	return event.Schedule.FieldId == subscription.filter.FieldId &&
+		!date.Before(subscription.filter.StartDate) &&
+		date.Before(subscription.filter.EndDate)
+}
+
+type ScheduleBroadcasterImpl struct {
+	mu sync.Mutex
+
+	// epoch tells tokens of this process apart from those of a previous
+	// run or another replica, whose sequence numbers mean nothing here.
+	epoch string
+	seq   uint64
+
+	// history keeps the last events in a ring so streams can resume.
+	history []dto.ScheduleEvent
+	next    int
+
+	bufferSize  int
+	subscribers map[*ScheduleSubscription]struct{}
+}
+
+// NewScheduleBroadcaster keeps historySize events for resuming and buffers up
+// to bufferSize events per subscriber before dropping it as too slow.
+func NewScheduleBroadcaster(historySize int, bufferSize int) ScheduleBroadcaster {
+	epoch := make([]byte, 4)
+	if _, err := rand.Read(epoch); err != nil {
+		epoch = []byte(strconv.FormatInt(time.Now().UnixNano(), 36))
+	}
+
+	return &ScheduleBroadcasterImpl{
+		epoch:       hex.EncodeToString(epoch),
+		history:     make([]dto.ScheduleEvent, 0, historySize),
+		bufferSize:  bufferSize,
+		subscribers: make(map[*ScheduleSubscription]struct{}),
+	}
+}
+
+// Publish implements ScheduleBroadcaster. It never blocks: a subscriber whose
+// buffer is full is closed with ErrSlowConsumer instead.
+func (broadcaster *ScheduleBroadcasterImpl) Publish(event dto.ScheduleEvent) {
+	broadcaster.mu.Lock()
+	defer broadcaster.mu.Unlock()
+
+	broadcaster.seq++
+	event.Seq = broadcaster.seq
+
+	if cap(broadcaster.history) > 0 {
+		if len(broadcaster.history) < cap(broadcaster.history) {
+			broadcaster.history = append(broadcaster.history, event)
+		} else {
+			broadcaster.history[broadcaster.next] = event
+		}
+		broadcaster.next = (broadcaster.next + 1) % cap(broadcaster.history)
+	}
+
+	for subscription := range broadcaster.subscribers {
+		if !subscription.matches(&event) {
+			continue
+		}
+		select {
+		case subscription.events <- event:
+		default:
+			broadcaster.close(subscription, ErrSlowConsumer)
+		}
+	}
+}
+
+// Subscribe implements ScheduleBroadcaster. With a resume token the kept
+// events published after it are replayed first.
+func (broadcaster *ScheduleBroadcasterImpl) Subscribe(filter *dto.ScheduleFilter, resumeToken string) (*ScheduleSubscription, error) {
+	broadcaster.mu.Lock()
+	defer broadcaster.mu.Unlock()
+
+	var replay []dto.ScheduleEvent
+	if resumeToken != "" {
+		after, err := broadcaster.parseToken(resumeToken)
+		if err != nil {
+			return nil, err
+		}
+
+		replay, err = broadcaster.eventsAfter(after)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	subscription := &ScheduleSubscription{
+		filter: *filter,
+	}
+
+	var matched []dto.ScheduleEvent
+	for _, event := range replay {
+		if subscription.matches(&event) {
+			matched = append(matched, event)
+		}
+	}
+
+	subscription.events = make(chan dto.ScheduleEvent, broadcaster.bufferSize+len(matched))
+	subscription.Events = subscription.events
+	for _, event := range matched {
+		subscription.events <- event
+	}
+
+	broadcaster.subscribers[subscription] = struct{}{}
+
+	return subscription, nil
+}
+
+// Unsubscribe implements ScheduleBroadcaster
+func (broadcaster *ScheduleBroadcasterImpl) Unsubscribe(subscription *ScheduleSubscription) {
+	broadcaster.mu.Lock()
+	defer broadcaster.mu.Unlock()
+
+	broadcaster.close(subscription, nil)
+}
+
+// ResumeToken implements ScheduleBroadcaster
+func (broadcaster *ScheduleBroadcasterImpl) ResumeToken(event *dto.ScheduleEvent) string {
+	return broadcaster.epoch + "." + strconv.FormatUint(event.Seq, 10)
+}
+
+func (broadcaster *ScheduleBroadcasterImpl) close(subscription *ScheduleSubscription, err error) {
+	if _, ok := broadcaster.subscribers[subscription]; !ok {
+		return
+	}
+
+	delete(broadcaster.subscribers, subscription)
+	subscription.err = err
+	close(subscription.events)
+}
+
+func (broadcaster *ScheduleBroadcasterImpl) parseToken(token string) (uint64, error) {
+	epoch, seq, ok := strings.Cut(token, ".")
+	if !ok {
+		return 0, ErrResumeUnavailable
+	}
+
+	after, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil || epoch != broadcaster.epoch || after > broadcaster.seq {
+		return 0, ErrResumeUnavailable
+	}
+
+	return after, nil
+}
+
+// eventsAfter returns the kept events with a sequence above after, oldest
+// first, or ErrResumeUnavailable when some of them were already evicted.
+func (broadcaster *ScheduleBroadcasterImpl) eventsAfter(after uint64) ([]dto.ScheduleEvent, error) {
+	missed := broadcaster.seq - after
+	if missed == 0 {
+		return nil, nil
+	}
+
+	if missed > uint64(len(broadcaster.history)) {
+		return nil, ErrResumeUnavailable
+	}
+
+	events := make([]dto.ScheduleEvent, 0, missed)
+	size := len(broadcaster.history)
+	for i := size - int(missed); i < size; i++ {
+		//oldest kept event sits at next once the ring is full
+		index := i
+		if size == cap(broadcaster.history) {
+			index = (broadcaster.next + i) % size
+		}
+		events = append(events, broadcaster.history[index])
+	}
+
+	return events, nil
+}
@@ -0,0 +1,181 @@
+package broadcast
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+)
+
+var day = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func fieldFilter(fieldId uint) *dto.ScheduleFilter {
+	return &dto.ScheduleFilter{FieldId: fieldId, StartDate: day, EndDate: day.AddDate(0, 0, 1)}
+}
+
+func scheduleEvent(id uint, fieldId uint, date time.Time) dto.ScheduleEvent {
+	return dto.ScheduleEvent{
+		Schedule: entity.Schedule{Id: id, FieldId: fieldId, Date: date},
+	}
+}
+
+// received drains the events already delivered to subscription.
+func received(subscription *ScheduleSubscription) []uint {
+	var ids []uint
+	for {
+		select {
+		case event, ok := <-subscription.Events:
+			if !ok {
+				return ids
+			}
+			ids = append(ids, event.Schedule.Id)
+		default:
+			return ids
+		}
+	}
+}
+
+func TestBroadcasterFanOut(t *testing.T) {
+	broadcaster := NewScheduleBroadcaster(0, 10)
+
+	first, err := broadcaster.Subscribe(fieldFilter(1), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := broadcaster.Subscribe(fieldFilter(1), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := broadcaster.Subscribe(fieldFilter(2), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	broadcaster.Publish(scheduleEvent(1, 1, day.Add(8*time.Hour)))
+	broadcaster.Publish(scheduleEvent(2, 2, day.Add(9*time.Hour)))
+	broadcaster.Publish(scheduleEvent(3, 1, day.AddDate(0, 0, 1))) // past the end date
+	broadcaster.Publish(scheduleEvent(4, 1, day.Add(-time.Hour)))  // before the start date
+	broadcaster.Publish(scheduleEvent(5, 1, day.Add(10*time.Hour)))
+
+	for name, test := range map[string]struct {
+		subscription *ScheduleSubscription
+		want         []uint
+	}{
+		"first":  {first, []uint{1, 5}},
+		"second": {second, []uint{1, 5}},
+		"other":  {other, []uint{2}},
+	} {
+		if got := received(test.subscription); !slices.Equal(got, test.want) {
+			t.Errorf("%s subscriber got %v, want %v", name, got, test.want)
+		}
+	}
+}
+
+func TestBroadcasterDropsSlowConsumer(t *testing.T) {
+	broadcaster := NewScheduleBroadcaster(0, 2)
+
+	slow, err := broadcaster.Subscribe(fieldFilter(1), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fast, err := broadcaster.Subscribe(fieldFilter(1), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fastIds []uint
+	for id := uint(1); id <= 4; id++ {
+		broadcaster.Publish(scheduleEvent(id, 1, day))
+		fastIds = append(fastIds, received(fast)...)
+	}
+
+	//the buffered events are still delivered before the channel closes
+	if got := received(slow); !slices.Equal(got, []uint{1, 2}) {
+		t.Errorf("slow subscriber got %v, want 1 2", got)
+	}
+	if _, ok := <-slow.Events; ok {
+		t.Fatal("slow subscriber not closed")
+	}
+	if !errors.Is(slow.Err(), ErrSlowConsumer) {
+		t.Errorf("got err %v, want ErrSlowConsumer", slow.Err())
+	}
+
+	if !slices.Equal(fastIds, []uint{1, 2, 3, 4}) {
+		t.Errorf("fast subscriber got %v, want 1 2 3 4", fastIds)
+	}
+	if fast.Err() != nil {
+		t.Errorf("fast subscriber closed with %v", fast.Err())
+	}
+
+	//unsubscribing a dropped subscription is a no-op
+	broadcaster.Unsubscribe(slow)
+}
+
+func TestBroadcasterUnsubscribe(t *testing.T) {
+	broadcaster := NewScheduleBroadcaster(0, 10)
+
+	subscription, err := broadcaster.Subscribe(fieldFilter(1), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	broadcaster.Unsubscribe(subscription)
+
+	if _, ok := <-subscription.Events; ok {
+		t.Fatal("events not closed")
+	}
+	if subscription.Err() != nil {
+		t.Errorf("got err %v, want nil after unsubscribe", subscription.Err())
+	}
+
+	//neither a publish nor a second unsubscribe touches the closed channel
+	broadcaster.Publish(scheduleEvent(1, 1, day))
+	broadcaster.Unsubscribe(subscription)
+
+	if got := len(broadcaster.(*ScheduleBroadcasterImpl).subscribers); got != 0 {
+		t.Errorf("got %d subscribers, want 0", got)
+	}
+}
+
+func TestBroadcasterResume(t *testing.T) {
+	broadcaster := NewScheduleBroadcaster(3, 10)
+
+	watcher, err := broadcaster.Subscribe(fieldFilter(1), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for id := uint(1); id <= 5; id++ {
+		broadcaster.Publish(scheduleEvent(id, 1, day))
+	}
+
+	var tokens []string
+	for _, id := range []uint{1, 2, 3, 4, 5} {
+		event := <-watcher.Events
+		if event.Schedule.Id != id {
+			t.Fatalf("got event %d, want %d", event.Schedule.Id, id)
+		}
+		tokens = append(tokens, broadcaster.ResumeToken(&event))
+	}
+
+	resumed, err := broadcaster.Subscribe(fieldFilter(1), tokens[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := received(resumed); !slices.Equal(got, []uint{3, 4, 5}) {
+		t.Errorf("resumed subscriber got %v, want 3 4 5", got)
+	}
+
+	//resuming after event 1 needs event 2, which fell out of the three kept
+	if _, err := broadcaster.Subscribe(fieldFilter(1), tokens[0]); !errors.Is(err, ErrResumeUnavailable) {
+		t.Errorf("got %v for an evicted token, want ErrResumeUnavailable", err)
+	}
+
+	//a token of another broadcaster, e.g. before a restart
+	if _, err := NewScheduleBroadcaster(3, 10).Subscribe(fieldFilter(1), tokens[4]); !errors.Is(err, ErrResumeUnavailable) {
+		t.Errorf("got %v for a foreign token, want ErrResumeUnavailable", err)
+	}
+}
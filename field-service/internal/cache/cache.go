@@ -0,0 +1,53 @@
+package cache
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Cache stores opaque values by key. The operations map to GET, SET with EX
+// and DEL so a Redis-compatible store can back it as well as the in-process
+// LRU. A zero ttl keeps the value until it is deleted or evicted.
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// Stats is a snapshot of the lookups counted by Counter.
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// HitRatio returns the share of lookups served from the cache, 0 before the
+// first lookup.
+func (s Stats) HitRatio() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// Counter counts cache hits and misses, safe for concurrent use.
+type Counter struct {
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+func (c *Counter) Hit() {
+	c.hits.Add(1)
+}
+
+func (c *Counter) Miss() {
+	c.misses.Add(1)
+}
+
+func (c *Counter) Stats() Stats {
+	return Stats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
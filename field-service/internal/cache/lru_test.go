@@ -0,0 +1,126 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(2)
+
+	c.Set(ctx, "a", []byte("1"), 0)
+	c.Set(ctx, "b", []byte("2"), 0)
+
+	//reading a makes b the least recently used
+	if _, ok, _ := c.Get(ctx, "a"); !ok {
+		t.Fatal("a missing before eviction")
+	}
+	c.Set(ctx, "c", []byte("3"), 0)
+
+	if _, ok, _ := c.Get(ctx, "b"); ok {
+		t.Error("b not evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok, _ := c.Get(ctx, key); !ok {
+			t.Errorf("%s evicted", key)
+		}
+	}
+	if c.Len() != 2 {
+		t.Errorf("got %d values, want 2", c.Len())
+	}
+
+	//overwriting a key does not evict another one
+	c.Set(ctx, "a", []byte("4"), 0)
+	if value, _, _ := c.Get(ctx, "a"); string(value) != "4" {
+		t.Errorf("got a = %q, want 4", value)
+	}
+	if _, ok, _ := c.Get(ctx, "c"); !ok {
+		t.Error("c evicted by an overwrite")
+	}
+}
+
+func TestLRUExpiresValues(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(10)
+
+	c.Set(ctx, "short", []byte("1"), 10*time.Millisecond)
+	c.Set(ctx, "long", []byte("2"), time.Hour)
+	c.Set(ctx, "forever", []byte("3"), 0)
+
+	if _, ok, _ := c.Get(ctx, "short"); !ok {
+		t.Fatal("short expired before its ttl")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok, _ := c.Get(ctx, "short"); ok {
+		t.Error("short not expired")
+	}
+	for _, key := range []string{"long", "forever"} {
+		if _, ok, _ := c.Get(ctx, key); !ok {
+			t.Errorf("%s expired", key)
+		}
+	}
+	if c.Len() != 2 {
+		t.Errorf("got %d values, want the expired one dropped", c.Len())
+	}
+}
+
+func TestLRUDelete(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(10)
+
+	c.Set(ctx, "a", []byte("1"), 0)
+	c.Set(ctx, "b", []byte("2"), 0)
+	c.Set(ctx, "c", []byte("3"), 0)
+
+	if err := c.Delete(ctx, "a", "c", "missing"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok, _ := c.Get(ctx, "a"); ok {
+		t.Error("a not deleted")
+	}
+	if _, ok, _ := c.Get(ctx, "b"); !ok {
+		t.Error("b deleted")
+	}
+	if c.Len() != 1 {
+		t.Errorf("got %d values, want 1", c.Len())
+	}
+}
+
+func TestLRUCopiesValues(t *testing.T) {
+	ctx := context.Background()
+	c := NewLRU(1)
+
+	value := []byte("abc")
+	c.Set(ctx, "a", value, 0)
+	value[0] = 'x'
+
+	if got, _, _ := c.Get(ctx, "a"); string(got) != "abc" {
+		t.Errorf("got %q, want the value as set", got)
+	}
+}
+
+func TestCounterStats(t *testing.T) {
+	var c Counter
+
+	if ratio := c.Stats().HitRatio(); ratio != 0 {
+		t.Errorf("got hit ratio %v before any lookup, want 0", ratio)
+	}
+
+	c.Hit()
+	c.Hit()
+	c.Hit()
+	c.Miss()
+
+	stats := c.Stats()
+	if stats.Hits != 3 || stats.Misses != 1 {
+		t.Errorf("got %+v, want 3 hits and 1 miss", stats)
+	}
+	if ratio := stats.HitRatio(); ratio != 0.75 {
+		t.Errorf("got hit ratio %v, want 0.75", ratio)
+	}
+}
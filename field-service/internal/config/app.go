@@ -1,12 +1,26 @@
 package config
 
 import (
+	"context"
 	"net"
+	"time"
 
 	fieldpb "github.com/DevisArya/learn-microservices-protorepo/pb/field"
+	pricingpb "github.com/DevisArya/learn-microservices-protorepo/pb/pricing"
+	reviewpb "github.com/DevisArya/learn-microservices-protorepo/pb/review"
+	schedulepb "github.com/DevisArya/learn-microservices-protorepo/pb/schedule"
+	transactionpb "github.com/DevisArya/learn-microservices-protorepo/pb/transaction"
+	venuepb "github.com/DevisArya/learn-microservices-protorepo/pb/venue"
+	"github.com/DevisArya/learn-microservices/field-service/internal/broadcast"
+	"github.com/DevisArya/learn-microservices/field-service/internal/cache"
 	"github.com/DevisArya/learn-microservices/field-service/internal/delivery/grpcdelivery"
+	"github.com/DevisArya/learn-microservices/field-service/internal/job"
+	"github.com/DevisArya/learn-microservices/field-service/internal/outbox"
 	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
 	"github.com/DevisArya/learn-microservices/field-service/internal/usecase"
+	"github.com/DevisArya/learn-microservices/svckit/grpcserver"
+	outboxkit "github.com/DevisArya/learn-microservices/svckit/outbox"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
 	"github.com/go-playground/validator/v10"
 	"google.golang.org/grpc"
 	"gorm.io/gorm"
@@ -15,6 +29,48 @@ import (
 type BootstrapConfig struct {
 	DB       *gorm.DB
 	Validate *validator.Validate
+
+	// ListenAddr is where the gRPC server listens, RequestTimeout bounds every
+	// unary call unless zero.
+	ListenAddr     string
+	RequestTimeout time.Duration
+
+	// ScheduleWeeks is how many weeks ahead the schedule generator materializes
+	// template slots, ScheduleInterval how often it runs.
+	ScheduleWeeks    uint32
+	ScheduleInterval time.Duration
+
+	// FieldRetention is how long soft deleted fields are kept before the purger
+	// removes them for good, FieldPurgeInterval how often it runs.
+	FieldRetention     time.Duration
+	FieldPurgeInterval time.Duration
+
+	// HoldDuration is how long a reserved slot is held before the hold sweeper
+	// releases it, HoldSweepInterval how often the sweeper runs.
+	HoldDuration      time.Duration
+	HoldSweepInterval time.Duration
+
+	// OutboxPublisher receives the domain events of the outbox, an in-memory
+	// publisher when nil. OutboxRelayInterval is how often the relay runs,
+	// OutboxBatchSize how many events it publishes per run.
+	OutboxPublisher     outboxkit.Publisher
+	OutboxRelayInterval time.Duration
+	OutboxBatchSize     int
+
+	// FieldCache backs the field read-through cache, an in-process LRU of
+	// FieldCacheSize entries when nil. Entries live for FieldCacheTTL; a zero
+	// FieldCacheTTL disables the cache. CacheReportInterval is how often the
+	// hit ratio is logged.
+	FieldCache          cache.Cache
+	FieldCacheSize      int
+	FieldCacheTTL       time.Duration
+	CacheReportInterval time.Duration
+
+	// WatchHistory is how many schedule events are kept for resuming
+	// WatchSchedules streams, WatchBuffer how many may queue up for one stream
+	// before it is dropped as too slow.
+	WatchHistory int
+	WatchBuffer  int
 }
 
 type BootstrapResult struct {
@@ -25,20 +81,85 @@ type BootstrapResult struct {
 func Bootstrap(cfg *BootstrapConfig) (*BootstrapResult, error) {
 
 	// Setup TCP listener
-	lis, err := net.Listen("tcp", ":50051")
+	lis, err := net.Listen("tcp", cfg.ListenAddr)
 	if err != nil {
 		return nil, err
 	}
 
 	//Init depedencies
-	fieldRepo := repository.NewFieldRepository()
-	fieldUc := usecase.NewFieldUseCase(fieldRepo, cfg.DB, cfg.Validate)
+	txManager := txmanager.NewManager(cfg.DB)
+
+	outboxRepo := outboxkit.NewRepository(cfg.DB)
+	outboxPublisher := cfg.OutboxPublisher
+	if outboxPublisher == nil {
+		outboxPublisher = outboxkit.NewMemoryPublisher()
+	}
+	outboxRelay := outboxkit.NewRelay(outboxRepo, outboxPublisher, txManager, outbox.Source)
+
+	venueRepo := repository.NewVenueRepository(cfg.DB)
+	venueUc := usecase.NewVenueUseCase(venueRepo, txManager, cfg.Validate)
+	venueCtrl := grpcdelivery.NewVenueController(venueUc)
+
+	var fieldCache *usecase.FieldCache
+	if cfg.FieldCacheTTL > 0 {
+		backend := cfg.FieldCache
+		if backend == nil {
+			backend = cache.NewLRU(cfg.FieldCacheSize)
+		}
+		fieldCache = usecase.NewFieldCache(backend, cfg.FieldCacheTTL)
+	}
+
+	fieldRepo := repository.NewFieldRepository(cfg.DB)
+	fieldUc := usecase.NewFieldUseCase(fieldRepo, venueRepo, outboxRepo, fieldCache, txManager, cfg.Validate)
 	fieldCtrl := grpcdelivery.NewFieldController(fieldUc)
 
+	scheduleRepo := repository.NewScheduleRepository(cfg.DB)
+	blackoutRepo := repository.NewBlackoutRepository(cfg.DB)
+	scheduleBroadcaster := broadcast.NewScheduleBroadcaster(cfg.WatchHistory, cfg.WatchBuffer)
+	transactionRepo := repository.NewTransactionRepository(cfg.DB)
+	scheduleUc := usecase.NewScheduleUseCase(scheduleRepo, fieldRepo, blackoutRepo, outboxRepo, transactionRepo, scheduleBroadcaster, txManager, cfg.Validate, cfg.HoldDuration)
+	scheduleTemplateRepo := repository.NewScheduleTemplateRepository(cfg.DB)
+	scheduleTemplateUc := usecase.NewScheduleTemplateUseCase(scheduleTemplateRepo, scheduleRepo, fieldRepo, blackoutRepo, txManager, cfg.Validate)
+	blackoutUc := usecase.NewBlackoutUseCase(blackoutRepo, scheduleRepo, fieldRepo, txManager, cfg.Validate)
+	scheduleCtrl := grpcdelivery.NewScheduleController(scheduleUc, scheduleTemplateUc, blackoutUc)
+
+	pricingRuleRepo := repository.NewPricingRuleRepository(cfg.DB)
+	pricingUc := usecase.NewPricingUseCase(pricingRuleRepo, scheduleRepo, fieldRepo, txManager, cfg.Validate)
+	pricingCtrl := grpcdelivery.NewPricingController(pricingUc)
+
+	reviewRepo := repository.NewReviewRepository(cfg.DB)
+	reviewUc := usecase.NewReviewUseCase(reviewRepo, scheduleRepo, fieldRepo, fieldCache, txManager, cfg.Validate)
+	reviewCtrl := grpcdelivery.NewReviewController(reviewUc)
+
+	transactionUc := usecase.NewTransactionUseCase(transactionRepo, scheduleRepo, fieldRepo, blackoutRepo, pricingRuleRepo, outboxRepo, scheduleBroadcaster, txManager, cfg.Validate, cfg.HoldDuration)
+	transactionCtrl := grpcdelivery.NewTransactionController(transactionUc)
+
+	//start background jobs
+	if cfg.ScheduleWeeks > 0 && cfg.ScheduleInterval > 0 {
+		job.NewScheduleGenerator(scheduleTemplateUc, cfg.ScheduleWeeks, cfg.ScheduleInterval).Start(context.Background())
+	}
+	if cfg.FieldRetention > 0 && cfg.FieldPurgeInterval > 0 {
+		job.NewFieldPurger(fieldUc, cfg.FieldRetention, cfg.FieldPurgeInterval).Start(context.Background())
+	}
+	if cfg.HoldDuration > 0 && cfg.HoldSweepInterval > 0 {
+		job.NewHoldSweeper(scheduleUc, cfg.HoldSweepInterval).Start(context.Background())
+	}
+	if fieldCache != nil && cfg.CacheReportInterval > 0 {
+		job.NewCacheReporter("field", fieldCache.Stats, cfg.CacheReportInterval).Start(context.Background())
+	}
+	if cfg.OutboxRelayInterval > 0 && cfg.OutboxBatchSize > 0 {
+		outboxkit.NewRelayJob(outboxRelay, cfg.OutboxBatchSize, cfg.OutboxRelayInterval).Start(context.Background())
+	}
+
 	//init grpc server & register service
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(grpcserver.TimeoutInterceptor(cfg.RequestTimeout)))
 	fieldpb.RegisterFieldServiceServer(grpcServer, fieldCtrl)
+	schedulepb.RegisterScheduleServiceServer(grpcServer, scheduleCtrl)
+	pricingpb.RegisterPricingServiceServer(grpcServer, pricingCtrl)
+	venuepb.RegisterVenueServiceServer(grpcServer, venueCtrl)
+	reviewpb.RegisterReviewServiceServer(grpcServer, reviewCtrl)
+	transactionpb.RegisterTransactionServiceServer(grpcServer, transactionCtrl)
 
 	return &BootstrapResult{
 		GRPCServer: grpcServer,
@@ -0,0 +1,161 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/usecase"
+	"github.com/DevisArya/learn-microservices/svcconfig"
+)
+
+// Settings is the configuration of the field service: the settings every
+// service shares and the tunables of its background jobs and caches. A zero
+// interval turns the job it belongs to off, a zero cache TTL the cache.
+type Settings struct {
+	svcconfig.Config `yaml:",inline"`
+
+	Schedule ScheduleSettings `yaml:"schedule" toml:"schedule" env:"SCHEDULE"`
+	Hold     HoldSettings     `yaml:"hold" toml:"hold" env:"HOLD"`
+	Purge    PurgeSettings    `yaml:"purge" toml:"purge" env:"PURGE"`
+	Outbox   OutboxSettings   `yaml:"outbox" toml:"outbox" env:"OUTBOX"`
+	Cache    CacheSettings    `yaml:"cache" toml:"cache" env:"CACHE"`
+	Watch    WatchSettings    `yaml:"watch" toml:"watch" env:"WATCH"`
+}
+
+// ScheduleSettings drives the schedule generator: how many weeks ahead it
+// materializes template slots and how often it runs.
+type ScheduleSettings struct {
+	Weeks    uint32        `yaml:"weeks" toml:"weeks" env:"WEEKS"`
+	Interval time.Duration `yaml:"interval" toml:"interval" env:"INTERVAL"`
+}
+
+// HoldSettings is how long a reserved slot is held and how often the hold
+// sweeper releases the expired ones.
+type HoldSettings struct {
+	Duration      time.Duration `yaml:"duration" toml:"duration" env:"DURATION"`
+	SweepInterval time.Duration `yaml:"sweep_interval" toml:"sweep_interval" env:"SWEEP_INTERVAL"`
+}
+
+// PurgeSettings is how long soft deleted fields are kept before the purger
+// removes them for good and how often the purger runs.
+type PurgeSettings struct {
+	Retention time.Duration `yaml:"retention" toml:"retention" env:"RETENTION"`
+	Interval  time.Duration `yaml:"interval" toml:"interval" env:"INTERVAL"`
+}
+
+// OutboxSettings is how often the outbox relay runs and how many events it
+// publishes per run.
+type OutboxSettings struct {
+	RelayInterval time.Duration `yaml:"relay_interval" toml:"relay_interval" env:"RELAY_INTERVAL"`
+	BatchSize     int           `yaml:"batch_size" toml:"batch_size" env:"BATCH_SIZE"`
+}
+
+// CacheSettings sizes the field read-through cache, how long its entries live
+// and how often its hit ratio is logged.
+type CacheSettings struct {
+	Size           int           `yaml:"size" toml:"size" env:"SIZE"`
+	TTL            time.Duration `yaml:"ttl" toml:"ttl" env:"TTL"`
+	ReportInterval time.Duration `yaml:"report_interval" toml:"report_interval" env:"REPORT_INTERVAL"`
+}
+
+// WatchSettings is how many schedule events are kept for resuming
+// WatchSchedules streams and how many may queue up for one stream.
+type WatchSettings struct {
+	History int `yaml:"history" toml:"history" env:"HISTORY"`
+	Buffer  int `yaml:"buffer" toml:"buffer" env:"BUFFER"`
+}
+
+// LoadSettings returns the settings of the field service, read from the
+// FIELD_* environment variables and the file named by FIELD_CONFIG_FILE over
+// its defaults.
+func LoadSettings() (*Settings, error) {
+	defaults := Settings{
+		Config: svcconfig.Defaults(),
+		Schedule: ScheduleSettings{
+			Weeks:    4,
+			Interval: time.Hour,
+		},
+		Hold: HoldSettings{
+			Duration:      15 * time.Minute,
+			SweepInterval: time.Minute,
+		},
+		Purge: PurgeSettings{
+			Retention: 30 * 24 * time.Hour,
+			Interval:  24 * time.Hour,
+		},
+		Outbox: OutboxSettings{
+			RelayInterval: 5 * time.Second,
+			BatchSize:     100,
+		},
+		Cache: CacheSettings{
+			Size:           10000,
+			TTL:            time.Minute,
+			ReportInterval: 5 * time.Minute,
+		},
+		Watch: WatchSettings{
+			History: 1024,
+			Buffer:  64,
+		},
+	}
+	defaults.ListenAddr = ":50051"
+	//the password is not kept in code, set it through FIELD_DB_DSN or the config file
+	defaults.DB.DSN = "field_service@tcp(localhost:3306)/field_service?charset=utf8mb4&parseTime=True&loc=UTC"
+
+	return svcconfig.Load("FIELD", defaults)
+}
+
+// Validate reports every setting that the service can not run with.
+func (s *Settings) Validate() error {
+	errs := []error{s.Config.Validate()}
+
+	if s.Schedule.Weeks > usecase.MaxGenerateWeeks {
+		errs = append(errs, fmt.Errorf("schedule.weeks: must not exceed %d", usecase.MaxGenerateWeeks))
+	}
+	if s.Schedule.Interval < 0 {
+		errs = append(errs, errors.New("schedule.interval: must not be negative"))
+	}
+
+	if s.Hold.Duration < 0 {
+		errs = append(errs, errors.New("hold.duration: must not be negative"))
+	}
+	if s.Hold.SweepInterval < 0 {
+		errs = append(errs, errors.New("hold.sweep_interval: must not be negative"))
+	}
+
+	if s.Purge.Retention < 0 {
+		errs = append(errs, errors.New("purge.retention: must not be negative"))
+	}
+	if s.Purge.Interval < 0 {
+		errs = append(errs, errors.New("purge.interval: must not be negative"))
+	}
+
+	if s.Outbox.RelayInterval < 0 {
+		errs = append(errs, errors.New("outbox.relay_interval: must not be negative"))
+	}
+	if s.Outbox.BatchSize < 0 {
+		errs = append(errs, errors.New("outbox.batch_size: must not be negative"))
+	}
+
+	if s.Cache.Size < 0 {
+		errs = append(errs, errors.New("cache.size: must not be negative"))
+	}
+	if s.Cache.TTL < 0 {
+		errs = append(errs, errors.New("cache.ttl: must not be negative"))
+	}
+	if s.Cache.TTL > 0 && s.Cache.Size == 0 {
+		errs = append(errs, errors.New("cache.size: is required when cache.ttl is set"))
+	}
+	if s.Cache.ReportInterval < 0 {
+		errs = append(errs, errors.New("cache.report_interval: must not be negative"))
+	}
+
+	if s.Watch.History < 0 {
+		errs = append(errs, errors.New("watch.history: must not be negative"))
+	}
+	if s.Watch.Buffer <= 0 {
+		errs = append(errs, errors.New("watch.buffer: must be positive"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,55 @@
+package grpcdelivery
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys carrying the caller identity, set by the gateway in front of
+// the services after authenticating the user.
+const (
+	metadataUserId = "x-user-id"
+	metadataRole   = "x-user-role"
+)
+
+func callerFromContext(ctx context.Context) (*dto.Caller, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing caller metadata")
+	}
+
+	userIds := md.Get(metadataUserId)
+	roles := md.Get(metadataRole)
+	if len(userIds) == 0 || len(roles) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing caller metadata")
+	}
+
+	userId, err := strconv.ParseUint(userIds[0], 10, 64)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid caller id")
+	}
+
+	return &dto.Caller{
+		UserId: uint(userId),
+		Role:   roles[0],
+	}, nil
+}
+
+func requireSuperUser(ctx context.Context) error {
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if caller.Role != string(entity.RoleSuperUser) {
+		return status.Error(codes.PermissionDenied, "super user only")
+	}
+
+	return nil
+}
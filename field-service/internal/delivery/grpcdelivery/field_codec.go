@@ -0,0 +1,272 @@
+package grpcdelivery
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+)
+
+// Formats accepted by ImportFields and produced by ExportFields.
+const (
+	fieldFormatCSV   = "csv"
+	fieldFormatJSONL = "jsonl"
+)
+
+// maxImportRows bounds how many rows a single import may carry.
+const maxImportRows = 10000
+
+// fieldColumns are the CSV columns of a field, in export order. Imports match
+// header names case-insensitively and ignore the id column.
+var fieldColumns = []string{"id", "name", "type", "description", "price", "venue_id", "operator_id", "timezone"}
+
+// fieldRecord is the JSON Lines form of a field.
+type fieldRecord struct {
+	Id          uint   `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Price       uint32 `json:"price"`
+	VenueId     *uint  `json:"venue_id,omitempty"`
+	OperatorId  *uint  `json:"operator_id,omitempty"`
+	Timezone    string `json:"timezone,omitempty"`
+}
+
+func (record *fieldRecord) toRequest() dto.FieldRequest {
+	return dto.FieldRequest{
+		Name:        record.Name,
+		Type:        record.Type,
+		Description: record.Description,
+		Price:       record.Price,
+		VenueId:     record.VenueId,
+		OperatorId:  record.OperatorId,
+		Timezone:    record.Timezone,
+	}
+}
+
+// decodeFieldRows reads the import file in format. Rows that can not be
+// decoded are returned with Err set so they are reported alongside the rows
+// failing validation; only an unreadable file fails as a whole.
+func decodeFieldRows(format string, r io.Reader) ([]dto.FieldImportRow, error) {
+	switch format {
+	case fieldFormatCSV:
+		return decodeFieldCSV(r)
+	case fieldFormatJSONL:
+		return decodeFieldJSONL(r)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func decodeFieldCSV(r io.Reader) ([]dto.FieldImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(fieldColumns, name) {
+			return nil, fmt.Errorf("unknown csv column %q", name)
+		}
+		columns[name] = i
+	}
+
+	var rows []dto.FieldImportRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+
+		if len(rows) == maxImportRows {
+			return nil, fmt.Errorf("import is limited to %d rows", maxImportRows)
+		}
+
+		var row dto.FieldImportRow
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, err
+			}
+			row.Row, row.Err = uint32(parseErr.StartLine), parseErr.Err
+		} else {
+			line, _ := reader.FieldPos(0)
+			row.Row = uint32(line)
+			row.Request, row.Err = csvFieldRequest(columns, record)
+		}
+		rows = append(rows, row)
+	}
+}
+
+func csvFieldRequest(columns map[string]int, record []string) (dto.FieldRequest, error) {
+	value := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	request := dto.FieldRequest{
+		Name:        value("name"),
+		Type:        value("type"),
+		Description: value("description"),
+		Timezone:    value("timezone"),
+	}
+
+	if price := value("price"); price != "" {
+		parsed, err := strconv.ParseUint(price, 10, 32)
+		if err != nil {
+			return request, fmt.Errorf("invalid price %q", price)
+		}
+		request.Price = uint32(parsed)
+	}
+
+	for name, target := range map[string]**uint{"venue_id": &request.VenueId, "operator_id": &request.OperatorId} {
+		raw := value(name)
+		if raw == "" {
+			continue
+		}
+		parsed, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			return request, fmt.Errorf("invalid %s %q", name, raw)
+		}
+		id := uint(parsed)
+		*target = &id
+	}
+
+	return request, nil
+}
+
+func decodeFieldJSONL(r io.Reader) ([]dto.FieldImportRow, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var rows []dto.FieldImportRow
+	for line := uint32(1); scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		if len(rows) == maxImportRows {
+			return nil, fmt.Errorf("import is limited to %d rows", maxImportRows)
+		}
+
+		var record fieldRecord
+		decoder := json.NewDecoder(bytes.NewReader(text))
+		decoder.DisallowUnknownFields()
+
+		row := dto.FieldImportRow{Row: line}
+		if err := decoder.Decode(&record); err != nil {
+			row.Err = err
+		} else {
+			row.Request = record.toRequest()
+		}
+		rows = append(rows, row)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// fieldEncoder writes exported fields in one of the export formats.
+type fieldEncoder interface {
+	Encode(fields *[]entity.Field) error
+	Flush() error
+}
+
+func newFieldEncoder(format string, w io.Writer) (fieldEncoder, error) {
+	switch format {
+	case fieldFormatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(fieldColumns); err != nil {
+			return nil, err
+		}
+		return &csvFieldEncoder{writer: writer}, nil
+	case fieldFormatJSONL:
+		return &jsonlFieldEncoder{encoder: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+type csvFieldEncoder struct {
+	writer *csv.Writer
+}
+
+func (encoder *csvFieldEncoder) Encode(fields *[]entity.Field) error {
+	for _, f := range *fields {
+		if err := encoder.writer.Write([]string{
+			strconv.FormatUint(uint64(f.Id), 10),
+			f.Name,
+			f.Type,
+			f.Description,
+			strconv.FormatUint(uint64(f.Price), 10),
+			formatOptionalId(f.VenueId),
+			formatOptionalId(f.OperatorId),
+			f.Timezone,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (encoder *csvFieldEncoder) Flush() error {
+	encoder.writer.Flush()
+	return encoder.writer.Error()
+}
+
+type jsonlFieldEncoder struct {
+	encoder *json.Encoder
+}
+
+func (encoder *jsonlFieldEncoder) Encode(fields *[]entity.Field) error {
+	for _, f := range *fields {
+		if err := encoder.encoder.Encode(&fieldRecord{
+			Id:          f.Id,
+			Name:        f.Name,
+			Type:        f.Type,
+			Description: f.Description,
+			Price:       f.Price,
+			VenueId:     f.VenueId,
+			OperatorId:  f.OperatorId,
+			Timezone:    f.Timezone,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (encoder *jsonlFieldEncoder) Flush() error {
+	return nil
+}
+
+func formatOptionalId(id *uint) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.FormatUint(uint64(*id), 10)
+}
@@ -2,11 +2,16 @@ package grpcdelivery
 
 import (
 	"context"
+	"errors"
+	"io"
+	"time"
 
 	fieldpb "github.com/DevisArya/learn-microservices-protorepo/pb/field"
 	pagingpb "github.com/DevisArya/learn-microservices-protorepo/pb/pagination"
 	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
 	"github.com/DevisArya/learn-microservices/field-service/internal/usecase"
+	"github.com/DevisArya/learn-microservices/svckit/grpcserver"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -28,20 +33,41 @@ func NewFieldController(fieldUc usecase.FieldUseCase) FieldController {
 
 func (controller *FieldControllerImpl) GetFields(ctx context.Context, req *fieldpb.GetFieldsRequest) (*fieldpb.GetFieldsResponse, error) {
 
-	res, paging, err := controller.FieldUc.FindAll(ctx, req.GetLimit(), req.GetPage())
+	filter := dto.FieldFilter{
+		Type:      req.GetType(),
+		Search:    req.GetSearch(),
+		SortBy:    req.GetSortBy(),
+		SortOrder: req.GetSortOrder(),
+	}
+	if req.MinPrice != nil {
+		minPrice := uint32(req.GetMinPrice())
+		filter.MinPrice = &minPrice
+	}
+	if req.MaxPrice != nil {
+		maxPrice := uint32(req.GetMaxPrice())
+		filter.MaxPrice = &maxPrice
+	}
+
+	var res *[]entity.Field
+	var paging *dto.PaginationResponse
+	var err error
+
+	if req.Cursor != nil {
+		res, paging, err = controller.FieldUc.FindAllByCursor(ctx, &filter, &dto.CursorRequest{
+			Cursor:    req.GetCursor(),
+			Limit:     req.GetLimit(),
+			WithCount: req.GetWithCount(),
+		})
+	} else {
+		res, paging, err = controller.FieldUc.FindAll(ctx, &filter, req.GetLimit(), req.GetPage())
+	}
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpcserver.Error(err)
 	}
 
 	var fields []*fieldpb.Field
 	for _, f := range *res {
-		fields = append(fields, &fieldpb.Field{
-			Id:          uint32(f.Id),
-			Name:        f.Name,
-			Type:        f.Type,
-			Price:       uint64(f.Price),
-			Description: f.Description,
-		})
+		fields = append(fields, toFieldPb(&f))
 	}
 
 	return &fieldpb.GetFieldsResponse{
@@ -50,6 +76,8 @@ func (controller *FieldControllerImpl) GetFields(ctx context.Context, req *field
 			Limit:       paging.Limit,
 			TotalRecord: paging.TotalRecord,
 			TotalPage:   paging.TotalPage,
+			NextCursor:  paging.NextCursor,
+			PrevCursor:  paging.PrevCursor,
 		}, Data: fields,
 	}, nil
 }
@@ -58,31 +86,46 @@ func (controller *FieldControllerImpl) GetField(ctx context.Context, req *fieldp
 
 	res, err := controller.FieldUc.FindById(ctx, uint(req.GetId()))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpcserver.Error(err)
 	}
 
 	return &fieldpb.GetFieldResponse{
-		Field: &fieldpb.Field{
-			Id:          uint32(res.Id),
-			Name:        res.Name,
-			Type:        res.Type,
-			Description: res.Description,
-			Price:       uint64(res.Price),
-		},
+		Field: toFieldPb(res),
 	}, nil
 }
 
 func (controller *FieldControllerImpl) CreateField(ctx context.Context, req *fieldpb.CreateFieldRequest) (*fieldpb.CreateFieldResponse, error) {
 
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	fieldReq := dto.FieldRequest{
 		Name:        req.GetName(),
 		Type:        req.GetType(),
 		Description: req.GetDescription(),
 		Price:       uint32(req.GetPrice()),
+		Timezone:    req.GetTimezone(),
+		MinDuration: req.GetMinDuration(),
+		MaxDuration: req.GetMaxDuration(),
+		Granularity: req.GetGranularity(),
+	}
+	if req.VenueId != nil {
+		venueId := uint(req.GetVenueId())
+		fieldReq.VenueId = &venueId
+	}
+	if req.OperatorId != nil {
+		operatorId := uint(req.GetOperatorId())
+		fieldReq.OperatorId = &operatorId
+	}
+	if req.ParentId != nil {
+		parentId := uint(req.GetParentId())
+		fieldReq.ParentId = &parentId
 	}
-	field, err := controller.FieldUc.Save(ctx, &fieldReq)
+	field, err := controller.FieldUc.Save(ctx, caller, &fieldReq)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpcserver.Error(err)
 	}
 
 	return &fieldpb.CreateFieldResponse{
@@ -92,15 +135,41 @@ func (controller *FieldControllerImpl) CreateField(ctx context.Context, req *fie
 }
 
 func (controller *FieldControllerImpl) UpdateField(ctx context.Context, req *fieldpb.UpdateFieldRequest) (*fieldpb.StatusResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	fieldReq := dto.FieldRequest{
 		Name:        req.GetName(),
 		Type:        req.GetType(),
 		Description: req.GetDescription(),
 		Price:       uint32(req.GetPrice()),
 	}
+	if req.VenueId != nil {
+		venueId := uint(req.GetVenueId())
+		fieldReq.VenueId = &venueId
+	}
+	if req.ParentId != nil {
+		parentId := uint(req.GetParentId())
+		fieldReq.ParentId = &parentId
+	}
+	if req.Timezone != nil {
+		fieldReq.Timezone = req.GetTimezone()
+	}
+	if req.MinDuration != nil {
+		fieldReq.MinDuration = req.GetMinDuration()
+	}
+	if req.MaxDuration != nil {
+		fieldReq.MaxDuration = req.GetMaxDuration()
+	}
+	if req.Granularity != nil {
+		fieldReq.Granularity = req.GetGranularity()
+	}
 
-	if err := controller.FieldUc.Update(ctx, &fieldReq, uint(req.GetId())); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	if err := controller.FieldUc.Update(ctx, caller, &fieldReq, uint(req.GetId())); err != nil {
+		return nil, grpcserver.Error(err)
 	}
 
 	return &fieldpb.StatusResponse{
@@ -109,11 +178,270 @@ func (controller *FieldControllerImpl) UpdateField(ctx context.Context, req *fie
 }
 func (controller *FieldControllerImpl) DeleteField(ctx context.Context, req *fieldpb.Id) (*fieldpb.StatusResponse, error) {
 
-	if err := controller.FieldUc.Delete(ctx, uint(req.GetId())); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := controller.FieldUc.Delete(ctx, caller, uint(req.GetId())); err != nil {
+		return nil, grpcserver.Error(err)
 	}
 
 	return &fieldpb.StatusResponse{
 		Message: "Succes delete",
 	}, nil
 }
+
+func (controller *FieldControllerImpl) SearchAvailability(ctx context.Context, req *fieldpb.SearchAvailabilityRequest) (*fieldpb.SearchAvailabilityResponse, error) {
+
+	//the search spans fields of any time zone, so it needs absolute times
+	startDate, err := time.Parse(time.RFC3339, req.GetStartDate())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid start date: "+err.Error())
+	}
+
+	endDate, err := time.Parse(time.RFC3339, req.GetEndDate())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid end date: "+err.Error())
+	}
+
+	filter := dto.AvailabilityFilter{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Type:      req.GetType(),
+		MinSlots:  req.GetMinSlots(),
+	}
+	if req.MinPrice != nil {
+		minPrice := uint32(req.GetMinPrice())
+		filter.MinPrice = &minPrice
+	}
+	if req.MaxPrice != nil {
+		maxPrice := uint32(req.GetMaxPrice())
+		filter.MaxPrice = &maxPrice
+	}
+
+	res, err := controller.FieldUc.SearchAvailability(ctx, &filter)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	var data []*fieldpb.FieldAvailability
+	for _, f := range *res {
+		loc := f.Location()
+		var slots []*fieldpb.Slot
+		for _, s := range f.Schedule {
+			slots = append(slots, &fieldpb.Slot{
+				Id:      uint32(s.Id),
+				Date:    s.Date.In(loc).Format(time.RFC3339),
+				EndDate: s.EndDate.In(loc).Format(time.RFC3339),
+			})
+		}
+
+		data = append(data, &fieldpb.FieldAvailability{
+			Field: toFieldPb(&f),
+			Slots: slots,
+		})
+	}
+
+	return &fieldpb.SearchAvailabilityResponse{
+		Data: data,
+	}, nil
+}
+
+func (controller *FieldControllerImpl) RestoreField(ctx context.Context, req *fieldpb.Id) (*fieldpb.StatusResponse, error) {
+
+	if err := requireSuperUser(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := controller.FieldUc.Restore(ctx, uint(req.GetId())); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &fieldpb.StatusResponse{
+		Message: "Success restore",
+	}, nil
+}
+
+func (controller *FieldControllerImpl) ListDeletedFields(ctx context.Context, req *fieldpb.ListDeletedFieldsRequest) (*fieldpb.GetFieldsResponse, error) {
+
+	if err := requireSuperUser(ctx); err != nil {
+		return nil, err
+	}
+
+	res, paging, err := controller.FieldUc.FindAllDeleted(ctx, req.GetLimit(), req.GetPage())
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	var fields []*fieldpb.Field
+	for _, f := range *res {
+		fields = append(fields, toFieldPb(&f))
+	}
+
+	return &fieldpb.GetFieldsResponse{
+		Pagination: &pagingpb.Pagination{
+			CurrentPage: paging.CurrentPage,
+			Limit:       paging.Limit,
+			TotalRecord: paging.TotalRecord,
+			TotalPage:   paging.TotalPage,
+		}, Data: fields,
+	}, nil
+}
+
+func (controller *FieldControllerImpl) ImportFields(stream fieldpb.FieldService_ImportFieldsServer) error {
+
+	ctx := stream.Context()
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "empty import")
+	}
+	if err != nil {
+		return err
+	}
+
+	rows, err := decodeFieldRows(first.GetFormat(), &importReader{stream: stream, data: first.GetData()})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := controller.FieldUc.Import(ctx, caller, &dto.FieldImportRequest{
+		Mode: first.GetMode(),
+		Rows: rows,
+	})
+	if err != nil {
+		return grpcserver.Error(err)
+	}
+
+	response := &fieldpb.ImportFieldsResponse{
+		TotalRows: res.TotalRows,
+	}
+	for _, id := range res.Ids {
+		response.Ids = append(response.Ids, uint32(id))
+	}
+	for _, e := range res.Errors {
+		response.Errors = append(response.Errors, &fieldpb.ImportRowError{
+			Row:     e.Row,
+			Field:   e.Field,
+			Rule:    e.Rule,
+			Message: e.Message,
+		})
+	}
+
+	return stream.SendAndClose(response)
+}
+
+func (controller *FieldControllerImpl) ExportFields(req *fieldpb.ExportFieldsRequest, stream fieldpb.FieldService_ExportFieldsServer) error {
+
+	writer := &exportWriter{stream: stream}
+
+	encoder, err := newFieldEncoder(req.GetFormat(), writer)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := dto.FieldFilter{
+		Type:   req.GetType(),
+		Search: req.GetSearch(),
+	}
+
+	if err := controller.FieldUc.Export(stream.Context(), &filter, encoder.Encode); err != nil {
+		return grpcserver.Error(err)
+	}
+
+	if err := encoder.Flush(); err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
+
+// importReader reads the data chunks of an ImportFields stream as one file.
+type importReader struct {
+	stream fieldpb.FieldService_ImportFieldsServer
+	data   []byte
+}
+
+func (reader *importReader) Read(p []byte) (int, error) {
+	for len(reader.data) == 0 {
+		req, err := reader.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		reader.data = req.GetData()
+	}
+
+	n := copy(p, reader.data)
+	reader.data = reader.data[n:]
+	return n, nil
+}
+
+// exportChunkSize is the size ExportFields buffers up to before sending a message.
+const exportChunkSize = 32 * 1024
+
+// exportWriter sends written bytes as ExportFields messages of about
+// exportChunkSize each.
+type exportWriter struct {
+	stream fieldpb.FieldService_ExportFieldsServer
+	buf    []byte
+}
+
+func (writer *exportWriter) Write(p []byte) (int, error) {
+	writer.buf = append(writer.buf, p...)
+	if len(writer.buf) >= exportChunkSize {
+		if err := writer.Flush(); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+func (writer *exportWriter) Flush() error {
+	if len(writer.buf) == 0 {
+		return nil
+	}
+
+	if err := writer.stream.Send(&fieldpb.ExportFieldsResponse{Data: writer.buf}); err != nil {
+		return err
+	}
+	writer.buf = nil
+	return nil
+}
+
+func toFieldPb(f *entity.Field) *fieldpb.Field {
+	field := &fieldpb.Field{
+		Id:            uint32(f.Id),
+		Name:          f.Name,
+		Type:          f.Type,
+		Price:         uint64(f.Price),
+		Description:   f.Description,
+		RatingAverage: f.RatingAverage,
+		RatingCount:   f.RatingCount,
+		Timezone:      f.Timezone,
+		MinDuration:   f.MinDuration,
+		MaxDuration:   f.MaxDuration,
+		Granularity:   f.Granularity,
+	}
+	if f.VenueId != nil {
+		field.VenueId = uint32(*f.VenueId)
+	}
+	if f.OperatorId != nil {
+		field.OperatorId = uint32(*f.OperatorId)
+	}
+	if f.ParentId != nil {
+		field.ParentId = uint32(*f.ParentId)
+	}
+	if f.DeletedAt.Valid {
+		field.DeletedAt = f.DeletedAt.Time.Format(time.RFC3339)
+	}
+	return field
+}
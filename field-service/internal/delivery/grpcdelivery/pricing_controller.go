@@ -0,0 +1,175 @@
+package grpcdelivery
+
+import (
+	"context"
+	"time"
+
+	pricingpb "github.com/DevisArya/learn-microservices-protorepo/pb/pricing"
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/usecase"
+	"github.com/DevisArya/learn-microservices/svckit/grpcserver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type PricingController interface {
+	pricingpb.PricingServiceServer
+}
+
+type PricingControllerImpl struct {
+	PricingUc usecase.PricingUseCase
+	pricingpb.UnimplementedPricingServiceServer
+}
+
+func NewPricingController(pricingUc usecase.PricingUseCase) PricingController {
+	return &PricingControllerImpl{
+		PricingUc: pricingUc,
+	}
+}
+
+func (controller *PricingControllerImpl) GetPricingRules(ctx context.Context, req *pricingpb.GetPricingRulesRequest) (*pricingpb.GetPricingRulesResponse, error) {
+
+	res, err := controller.PricingUc.FindByField(ctx, uint(req.GetFieldId()))
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	var rules []*pricingpb.PricingRule
+	for _, r := range *res {
+		rule := &pricingpb.PricingRule{
+			Id:        uint32(r.Id),
+			FieldId:   uint32(r.FieldId),
+			Name:      r.Name,
+			Kind:      string(r.Kind),
+			StartTime: r.StartTime,
+			EndTime:   r.EndTime,
+			Percent:   r.Percent,
+			Price:     uint64(r.Price),
+			Priority:  r.Priority,
+		}
+		for day := uint32(0); day < 7; day++ {
+			if r.Weekdays&(1<<day) != 0 {
+				rule.Weekdays = append(rule.Weekdays, day)
+			}
+		}
+		if r.ValidFrom != nil {
+			rule.ValidFrom = r.ValidFrom.Format(time.RFC3339)
+		}
+		if r.ValidUntil != nil {
+			rule.ValidUntil = r.ValidUntil.Format(time.RFC3339)
+		}
+		rules = append(rules, rule)
+	}
+
+	return &pricingpb.GetPricingRulesResponse{
+		Data: rules,
+	}, nil
+}
+
+func (controller *PricingControllerImpl) CreatePricingRule(ctx context.Context, req *pricingpb.CreatePricingRuleRequest) (*pricingpb.CreatePricingRuleResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleReq := dto.PricingRuleRequest{
+		FieldId:   uint(req.GetFieldId()),
+		Name:      req.GetName(),
+		Kind:      req.GetKind(),
+		StartTime: req.GetStartTime(),
+		EndTime:   req.GetEndTime(),
+		Percent:   req.GetPercent(),
+		Price:     uint32(req.GetPrice()),
+		Priority:  req.GetPriority(),
+	}
+
+	for _, day := range req.GetWeekdays() {
+		if day > 6 {
+			return nil, status.Error(codes.InvalidArgument, "weekday must be between 0 (sunday) and 6 (saturday)")
+		}
+		ruleReq.Weekdays = append(ruleReq.Weekdays, uint8(day))
+	}
+
+	if req.ValidFrom != nil {
+		validFrom, err := time.Parse(time.RFC3339, req.GetValidFrom())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid valid from: "+err.Error())
+		}
+		ruleReq.ValidFrom = &validFrom
+	}
+
+	if req.ValidUntil != nil {
+		validUntil, err := time.Parse(time.RFC3339, req.GetValidUntil())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid valid until: "+err.Error())
+		}
+		ruleReq.ValidUntil = &validUntil
+	}
+
+	rule, err := controller.PricingUc.Save(ctx, caller, &ruleReq)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &pricingpb.CreatePricingRuleResponse{
+		Id: &pricingpb.Id{
+			Id: uint32(rule.Id),
+		},
+	}, nil
+}
+
+func (controller *PricingControllerImpl) DeletePricingRule(ctx context.Context, req *pricingpb.Id) (*pricingpb.StatusResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := controller.PricingUc.Delete(ctx, caller, uint(req.GetId())); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &pricingpb.StatusResponse{
+		Message: "Success delete",
+	}, nil
+}
+
+func (controller *PricingControllerImpl) QuotePrice(ctx context.Context, req *pricingpb.QuotePriceRequest) (*pricingpb.QuotePriceResponse, error) {
+
+	var scheduleIds []uint
+	for _, id := range req.GetScheduleIds() {
+		scheduleIds = append(scheduleIds, uint(id))
+	}
+
+	res, err := controller.PricingUc.Quote(ctx, scheduleIds)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	var total uint64
+	var quotes []*pricingpb.SlotQuote
+	for _, q := range *res {
+		var lines []*pricingpb.PriceLine
+		for _, l := range q.Lines {
+			lines = append(lines, &pricingpb.PriceLine{
+				Description: l.Description,
+				Amount:      l.Amount,
+			})
+		}
+
+		quotes = append(quotes, &pricingpb.SlotQuote{
+			ScheduleId: uint32(q.ScheduleId),
+			FieldId:    uint32(q.FieldId),
+			Date:       q.Date.Format(time.RFC3339),
+			Lines:      lines,
+			Total:      uint64(q.Total),
+		})
+		total += uint64(q.Total)
+	}
+
+	return &pricingpb.QuotePriceResponse{
+		Data:  quotes,
+		Total: total,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package grpcdelivery
+
+import (
+	"context"
+	"time"
+
+	pagingpb "github.com/DevisArya/learn-microservices-protorepo/pb/pagination"
+	reviewpb "github.com/DevisArya/learn-microservices-protorepo/pb/review"
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/usecase"
+	"github.com/DevisArya/learn-microservices/svckit/grpcserver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ReviewController interface {
+	reviewpb.ReviewServiceServer
+}
+
+type ReviewControllerImpl struct {
+	ReviewUc usecase.ReviewUseCase
+	reviewpb.UnimplementedReviewServiceServer
+}
+
+func NewReviewController(reviewUc usecase.ReviewUseCase) ReviewController {
+	return &ReviewControllerImpl{
+		ReviewUc: reviewUc,
+	}
+}
+
+func (controller *ReviewControllerImpl) GetReviews(ctx context.Context, req *reviewpb.GetReviewsRequest) (*reviewpb.GetReviewsResponse, error) {
+
+	res, paging, err := controller.ReviewUc.FindByField(ctx, uint(req.GetFieldId()), req.GetLimit(), req.GetPage())
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	var reviews []*reviewpb.Review
+	for _, r := range *res {
+		reviews = append(reviews, &reviewpb.Review{
+			Id:         uint32(r.Id),
+			FieldId:    uint32(r.FieldId),
+			ScheduleId: uint32(r.ScheduleId),
+			UserId:     uint32(r.UserId),
+			Rating:     uint32(r.Rating),
+			Comment:    r.Comment,
+			CreatedAt:  r.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &reviewpb.GetReviewsResponse{
+		Pagination: &pagingpb.Pagination{
+			CurrentPage: paging.CurrentPage,
+			Limit:       paging.Limit,
+			TotalRecord: paging.TotalRecord,
+			TotalPage:   paging.TotalPage,
+		}, Data: reviews,
+	}, nil
+}
+
+func (controller *ReviewControllerImpl) CreateReview(ctx context.Context, req *reviewpb.CreateReviewRequest) (*reviewpb.CreateReviewResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetRating() < 1 || req.GetRating() > 5 {
+		return nil, status.Error(codes.InvalidArgument, "rating must be between 1 and 5")
+	}
+
+	review, err := controller.ReviewUc.Save(ctx, caller, &dto.ReviewRequest{
+		ScheduleId: uint(req.GetScheduleId()),
+		Rating:     uint8(req.GetRating()),
+		Comment:    req.GetComment(),
+	})
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &reviewpb.CreateReviewResponse{
+		Id: &reviewpb.Id{
+			Id: uint32(review.Id),
+		},
+	}, nil
+}
+
+func (controller *ReviewControllerImpl) DeleteReview(ctx context.Context, req *reviewpb.Id) (*reviewpb.StatusResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := controller.ReviewUc.Delete(ctx, caller, uint(req.GetId())); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &reviewpb.StatusResponse{
+		Message: "Success delete review",
+	}, nil
+}
@@ -0,0 +1,482 @@
+package grpcdelivery
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	schedulepb "github.com/DevisArya/learn-microservices-protorepo/pb/schedule"
+	"github.com/DevisArya/learn-microservices/field-service/internal/broadcast"
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/usecase"
+	"github.com/DevisArya/learn-microservices/svckit/grpcserver"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// localTimeLayout is a wall clock time without offset, read in the field
+// time zone.
+const localTimeLayout = "2006-01-02T15:04:05"
+
+type ScheduleController interface {
+	schedulepb.ScheduleServiceServer
+}
+
+type ScheduleControllerImpl struct {
+	ScheduleUc         usecase.ScheduleUseCase
+	ScheduleTemplateUc usecase.ScheduleTemplateUseCase
+	BlackoutUc         usecase.BlackoutUseCase
+	schedulepb.UnimplementedScheduleServiceServer
+}
+
+func NewScheduleController(scheduleUc usecase.ScheduleUseCase, scheduleTemplateUc usecase.ScheduleTemplateUseCase, blackoutUc usecase.BlackoutUseCase) ScheduleController {
+	return &ScheduleControllerImpl{
+		ScheduleUc:         scheduleUc,
+		ScheduleTemplateUc: scheduleTemplateUc,
+		BlackoutUc:         blackoutUc,
+	}
+}
+
+func (controller *ScheduleControllerImpl) GetSchedules(ctx context.Context, req *schedulepb.GetSchedulesRequest) (*schedulepb.GetSchedulesResponse, error) {
+
+	loc, err := controller.ScheduleUc.Location(ctx, uint(req.GetFieldId()))
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	startDate, err := parseFieldTime(req.GetStartDate(), loc)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid start date: "+err.Error())
+	}
+
+	endDate, err := parseFieldTime(req.GetEndDate(), loc)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid end date: "+err.Error())
+	}
+
+	res, err := controller.ScheduleUc.FindByField(ctx, &dto.ScheduleFilter{
+		FieldId:   uint(req.GetFieldId()),
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	var schedules []*schedulepb.Schedule
+	for _, s := range *res {
+		schedules = append(schedules, toSchedulePb(&s, loc))
+	}
+
+	return &schedulepb.GetSchedulesResponse{
+		Data: schedules,
+	}, nil
+}
+
+func (controller *ScheduleControllerImpl) GetSchedule(ctx context.Context, req *schedulepb.Id) (*schedulepb.GetScheduleResponse, error) {
+
+	res, err := controller.ScheduleUc.FindById(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	loc, err := controller.ScheduleUc.Location(ctx, res.FieldId)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &schedulepb.GetScheduleResponse{
+		Schedule: toSchedulePb(res, loc),
+	}, nil
+}
+
+func (controller *ScheduleControllerImpl) CreateSchedule(ctx context.Context, req *schedulepb.CreateScheduleRequest) (*schedulepb.CreateScheduleResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := controller.ScheduleUc.Location(ctx, uint(req.GetFieldId()))
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	date, err := parseFieldTime(req.GetDate(), loc)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid date: "+err.Error())
+	}
+
+	endDate, err := parseFieldTime(req.GetEndDate(), loc)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid end date: "+err.Error())
+	}
+
+	scheduleReq := dto.ScheduleRequest{
+		FieldId: uint(req.GetFieldId()),
+		Date:    date,
+		EndDate: endDate,
+	}
+	schedule, err := controller.ScheduleUc.Save(ctx, caller, &scheduleReq)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &schedulepb.CreateScheduleResponse{
+		Id: &schedulepb.Id{
+			Id: uint32(schedule.Id),
+		},
+	}, nil
+}
+
+func (controller *ScheduleControllerImpl) UpdateScheduleStatus(ctx context.Context, req *schedulepb.UpdateScheduleStatusRequest) (*schedulepb.StatusResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statusReq := dto.ScheduleStatusRequest{
+		Status: req.GetStatus(),
+	}
+	if req.UserId != nil {
+		userId := uint(req.GetUserId())
+		statusReq.UserId = &userId
+	}
+
+	if err := controller.ScheduleUc.UpdateStatus(ctx, caller, &statusReq, uint(req.GetId().GetId())); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &schedulepb.StatusResponse{
+		Message: "Success update status",
+	}, nil
+}
+
+func (controller *ScheduleControllerImpl) DeleteSchedule(ctx context.Context, req *schedulepb.Id) (*schedulepb.StatusResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := controller.ScheduleUc.Delete(ctx, caller, uint(req.GetId())); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &schedulepb.StatusResponse{
+		Message: "Success delete",
+	}, nil
+}
+
+func (controller *ScheduleControllerImpl) ExtendHold(ctx context.Context, req *schedulepb.Id) (*schedulepb.ExtendHoldResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := controller.ScheduleUc.ExtendHold(ctx, caller, uint(req.GetId()))
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &schedulepb.ExtendHoldResponse{
+		HoldExpiresAt: expiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (controller *ScheduleControllerImpl) WatchSchedules(req *schedulepb.WatchSchedulesRequest, stream schedulepb.ScheduleService_WatchSchedulesServer) error {
+
+	//events may come from several fields, each shown in its own time zone
+	locations := make(map[uint]*time.Location)
+	location := func(fieldId uint) *time.Location {
+		loc, ok := locations[fieldId]
+		if !ok {
+			var err error
+			if loc, err = controller.ScheduleUc.Location(stream.Context(), fieldId); err != nil {
+				loc = time.UTC
+			}
+			locations[fieldId] = loc
+		}
+		return loc
+	}
+
+	loc := time.UTC
+	if req.GetFieldId() != 0 {
+		loc = location(uint(req.GetFieldId()))
+	}
+
+	startDate, err := parseFieldTime(req.GetStartDate(), loc)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid start date: "+err.Error())
+	}
+
+	endDate, err := parseFieldTime(req.GetEndDate(), loc)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, "invalid end date: "+err.Error())
+	}
+
+	subscription, err := controller.ScheduleUc.Watch(&dto.ScheduleFilter{
+		FieldId:   uint(req.GetFieldId()),
+		StartDate: startDate,
+		EndDate:   endDate,
+	}, req.GetResumeToken())
+	if err != nil {
+		if errors.Is(err, broadcast.ErrResumeUnavailable) {
+			return status.Error(codes.OutOfRange, err.Error())
+		}
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	defer controller.ScheduleUc.Unwatch(subscription)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-subscription.Events:
+			if !ok {
+				if errors.Is(subscription.Err(), broadcast.ErrSlowConsumer) {
+					return status.Error(codes.ResourceExhausted, subscription.Err().Error())
+				}
+				return nil
+			}
+
+			if err := stream.Send(&schedulepb.ScheduleEvent{
+				ResumeToken:    controller.ScheduleUc.ResumeToken(&event),
+				Schedule:       toSchedulePb(&event.Schedule, location(event.Schedule.FieldId)),
+				PreviousStatus: string(event.PreviousStatus),
+				OccurredAt:     event.OccurredAt.Format(time.RFC3339),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (controller *ScheduleControllerImpl) GetScheduleTemplates(ctx context.Context, req *schedulepb.GetScheduleTemplatesRequest) (*schedulepb.GetScheduleTemplatesResponse, error) {
+
+	res, err := controller.ScheduleTemplateUc.FindByField(ctx, uint(req.GetFieldId()))
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	var templates []*schedulepb.ScheduleTemplate
+	for _, t := range *res {
+		templates = append(templates, &schedulepb.ScheduleTemplate{
+			Id:           uint32(t.Id),
+			FieldId:      uint32(t.FieldId),
+			Weekday:      uint32(t.Weekday),
+			OpenTime:     t.OpenTime,
+			CloseTime:    t.CloseTime,
+			SlotDuration: t.SlotDuration,
+		})
+	}
+
+	return &schedulepb.GetScheduleTemplatesResponse{
+		Data: templates,
+	}, nil
+}
+
+func (controller *ScheduleControllerImpl) CreateScheduleTemplate(ctx context.Context, req *schedulepb.CreateScheduleTemplateRequest) (*schedulepb.CreateScheduleTemplateResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetWeekday() > 6 {
+		return nil, status.Error(codes.InvalidArgument, "weekday must be between 0 (sunday) and 6 (saturday)")
+	}
+
+	templateReq := dto.ScheduleTemplateRequest{
+		FieldId:      uint(req.GetFieldId()),
+		Weekday:      uint8(req.GetWeekday()),
+		OpenTime:     req.GetOpenTime(),
+		CloseTime:    req.GetCloseTime(),
+		SlotDuration: req.GetSlotDuration(),
+	}
+	template, err := controller.ScheduleTemplateUc.Save(ctx, caller, &templateReq)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &schedulepb.CreateScheduleTemplateResponse{
+		Id: &schedulepb.Id{
+			Id: uint32(template.Id),
+		},
+	}, nil
+}
+
+func (controller *ScheduleControllerImpl) DeleteScheduleTemplate(ctx context.Context, req *schedulepb.Id) (*schedulepb.StatusResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := controller.ScheduleTemplateUc.Delete(ctx, caller, uint(req.GetId())); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &schedulepb.StatusResponse{
+		Message: "Success delete template",
+	}, nil
+}
+
+func (controller *ScheduleControllerImpl) GenerateSchedules(ctx context.Context, req *schedulepb.GenerateSchedulesRequest) (*schedulepb.StatusResponse, error) {
+
+	//generation runs over every field, so only super users may trigger it
+	if err := requireSuperUser(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := controller.ScheduleTemplateUc.Generate(ctx, req.GetWeeks()); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &schedulepb.StatusResponse{
+		Message: "Success generate schedules",
+	}, nil
+}
+
+func (controller *ScheduleControllerImpl) GetBlackouts(ctx context.Context, req *schedulepb.GetBlackoutsRequest) (*schedulepb.GetBlackoutsResponse, error) {
+
+	loc, err := controller.ScheduleUc.Location(ctx, uint(req.GetFieldId()))
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	startDate, err := parseFieldTime(req.GetStartDate(), loc)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid start date: "+err.Error())
+	}
+
+	endDate, err := parseFieldTime(req.GetEndDate(), loc)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid end date: "+err.Error())
+	}
+
+	res, err := controller.BlackoutUc.FindByField(ctx, &dto.ScheduleFilter{
+		FieldId:   uint(req.GetFieldId()),
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	var blackouts []*schedulepb.Blackout
+	for _, b := range *res {
+		blackouts = append(blackouts, &schedulepb.Blackout{
+			Id:      uint32(b.Id),
+			FieldId: uint32(b.FieldId),
+			StartAt: b.StartAt.In(loc).Format(time.RFC3339),
+			EndAt:   b.EndAt.In(loc).Format(time.RFC3339),
+			Reason:  b.Reason,
+		})
+	}
+
+	return &schedulepb.GetBlackoutsResponse{
+		Data: blackouts,
+	}, nil
+}
+
+func (controller *ScheduleControllerImpl) CreateBlackout(ctx context.Context, req *schedulepb.CreateBlackoutRequest) (*schedulepb.CreateBlackoutResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := controller.ScheduleUc.Location(ctx, uint(req.GetFieldId()))
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	startAt, err := parseFieldTime(req.GetStartAt(), loc)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid start at: "+err.Error())
+	}
+
+	endAt, err := parseFieldTime(req.GetEndAt(), loc)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid end at: "+err.Error())
+	}
+
+	blackout, conflicts, err := controller.BlackoutUc.Save(ctx, caller, &dto.BlackoutRequest{
+		FieldId: uint(req.GetFieldId()),
+		StartAt: startAt,
+		EndAt:   endAt,
+		Reason:  req.GetReason(),
+		Force:   req.GetForce(),
+	})
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	var conflictPbs []*schedulepb.Schedule
+	for _, s := range *conflicts {
+		conflictPbs = append(conflictPbs, toSchedulePb(&s, loc))
+	}
+
+	return &schedulepb.CreateBlackoutResponse{
+		Id: &schedulepb.Id{
+			Id: uint32(blackout.Id),
+		},
+		Conflicts: conflictPbs,
+	}, nil
+}
+
+func (controller *ScheduleControllerImpl) DeleteBlackout(ctx context.Context, req *schedulepb.Id) (*schedulepb.StatusResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := controller.BlackoutUc.Delete(ctx, caller, uint(req.GetId())); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &schedulepb.StatusResponse{
+		Message: "Success delete blackout",
+	}, nil
+}
+
+// toSchedulePb converts a schedule stored in UTC to loc, the time zone of its
+// field.
+func toSchedulePb(s *entity.Schedule, loc *time.Location) *schedulepb.Schedule {
+	schedule := &schedulepb.Schedule{
+		Id:       uint32(s.Id),
+		FieldId:  uint32(s.FieldId),
+		Date:     s.Date.In(loc).Format(time.RFC3339),
+		EndDate:  s.EndDate.In(loc).Format(time.RFC3339),
+		Status:   string(s.Status),
+		Timezone: loc.String(),
+	}
+	if s.UserId != nil {
+		schedule.UserId = uint32(*s.UserId)
+	}
+	if s.HoldExpiresAt != nil {
+		schedule.HoldExpiresAt = s.HoldExpiresAt.In(loc).Format(time.RFC3339)
+	}
+	return schedule
+}
+
+// parseFieldTime parses an RFC 3339 time, or a wall clock time without offset
+// read in loc, the time zone of the field, and returns it in UTC.
+func parseFieldTime(value string, loc *time.Location) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t.UTC(), nil
+	}
+
+	local, localErr := time.ParseInLocation(localTimeLayout, value, loc)
+	if localErr != nil {
+		return time.Time{}, err
+	}
+
+	return local.UTC(), nil
+}
@@ -0,0 +1,193 @@
+package grpcdelivery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	schedulepb "github.com/DevisArya/learn-microservices-protorepo/pb/schedule"
+	"github.com/DevisArya/learn-microservices/field-service/internal/broadcast"
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchUseCase serves WatchSchedules from a broadcaster and reports the
+// subscriptions it hands out and takes back. Any other method panics.
+type watchUseCase struct {
+	usecase.ScheduleUseCase
+	broadcaster broadcast.ScheduleBroadcaster
+	watched     chan *broadcast.ScheduleSubscription
+	unwatched   chan *broadcast.ScheduleSubscription
+}
+
+func newWatchUseCase(bufferSize int) *watchUseCase {
+	return &watchUseCase{
+		broadcaster: broadcast.NewScheduleBroadcaster(0, bufferSize),
+		watched:     make(chan *broadcast.ScheduleSubscription, 1),
+		unwatched:   make(chan *broadcast.ScheduleSubscription, 1),
+	}
+}
+
+func (uc *watchUseCase) Location(ctx context.Context, fieldId uint) (*time.Location, error) {
+	return time.UTC, nil
+}
+
+func (uc *watchUseCase) Watch(filter *dto.ScheduleFilter, resumeToken string) (*broadcast.ScheduleSubscription, error) {
+	subscription, err := uc.broadcaster.Subscribe(filter, resumeToken)
+	if err == nil {
+		uc.watched <- subscription
+	}
+	return subscription, err
+}
+
+func (uc *watchUseCase) Unwatch(subscription *broadcast.ScheduleSubscription) {
+	uc.broadcaster.Unsubscribe(subscription)
+	uc.unwatched <- subscription
+}
+
+func (uc *watchUseCase) ResumeToken(event *dto.ScheduleEvent) string {
+	return uc.broadcaster.ResumeToken(event)
+}
+
+// watchStream records the sent events; Send blocks until release is closed.
+type watchStream struct {
+	schedulepb.ScheduleService_WatchSchedulesServer
+	ctx     context.Context
+	sent    chan *schedulepb.ScheduleEvent
+	release chan struct{}
+}
+
+func (stream *watchStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (stream *watchStream) Send(event *schedulepb.ScheduleEvent) error {
+	stream.sent <- event
+	<-stream.release
+	return nil
+}
+
+func startWatch(t *testing.T, uc *watchUseCase, stream *watchStream) (*broadcast.ScheduleSubscription, <-chan error) {
+	t.Helper()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- NewScheduleController(uc, nil, nil).WatchSchedules(&schedulepb.WatchSchedulesRequest{
+			FieldId:   1,
+			StartDate: "2030-01-01T00:00:00Z",
+			EndDate:   "2030-01-02T00:00:00Z",
+		}, stream)
+	}()
+
+	select {
+	case subscription := <-uc.watched:
+		return subscription, done
+	case err := <-done:
+		t.Fatalf("watch ended before subscribing: %v", err)
+	case <-time.After(time.Second):
+		t.Fatal("watch did not subscribe")
+	}
+	return nil, nil
+}
+
+func watchEvent(id uint) dto.ScheduleEvent {
+	return dto.ScheduleEvent{
+		Schedule: entity.Schedule{Id: id, FieldId: 1, Date: time.Date(2030, 1, 1, 8, 0, 0, 0, time.UTC)},
+	}
+}
+
+func TestWatchSchedulesUnsubscribesOnStreamClose(t *testing.T) {
+	uc := newWatchUseCase(10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	release := make(chan struct{})
+	close(release)
+	stream := &watchStream{ctx: ctx, sent: make(chan *schedulepb.ScheduleEvent, 10), release: release}
+
+	subscription, done := startWatch(t, uc, stream)
+
+	uc.broadcaster.Publish(watchEvent(1))
+	select {
+	case event := <-stream.sent:
+		if event.GetSchedule().GetId() != 1 || event.GetResumeToken() == "" {
+			t.Errorf("got %v, want schedule 1 with a resume token", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("event not sent")
+	}
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("got %v, want a clean end on stream close", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("watch did not end on stream close")
+	}
+
+	select {
+	case got := <-uc.unwatched:
+		if got != subscription {
+			t.Error("another subscription was unwatched")
+		}
+	default:
+		t.Error("subscription not unwatched")
+	}
+	select {
+	case _, ok := <-subscription.Events:
+		if ok {
+			t.Error("event left on a closed stream")
+		}
+	default:
+		t.Error("subscription still open after the stream closed")
+	}
+}
+
+func TestWatchSchedulesEndsSlowConsumer(t *testing.T) {
+	uc := newWatchUseCase(1)
+	release := make(chan struct{})
+	stream := &watchStream{ctx: context.Background(), sent: make(chan *schedulepb.ScheduleEvent, 10), release: release}
+
+	subscription, done := startWatch(t, uc, stream)
+
+	//the first event is stuck in Send, the second fills the buffer and the
+	//third overflows it
+	uc.broadcaster.Publish(watchEvent(1))
+	select {
+	case <-stream.sent:
+	case <-time.After(time.Second):
+		t.Fatal("event not sent")
+	}
+	uc.broadcaster.Publish(watchEvent(2))
+	uc.broadcaster.Publish(watchEvent(3))
+
+	close(release)
+
+	select {
+	case err := <-done:
+		if status.Code(err) != codes.ResourceExhausted {
+			t.Errorf("got %v, want ResourceExhausted", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("watch did not end for a slow consumer")
+	}
+
+	//the buffered event still went out before the stream ended
+	if event := <-stream.sent; event.GetSchedule().GetId() != 2 {
+		t.Errorf("got schedule %d, want 2", event.GetSchedule().GetId())
+	}
+	select {
+	case got := <-uc.unwatched:
+		if got != subscription {
+			t.Error("another subscription was unwatched")
+		}
+	default:
+		t.Error("subscription not unwatched")
+	}
+}
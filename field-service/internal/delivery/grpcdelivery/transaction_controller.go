@@ -0,0 +1,121 @@
+package grpcdelivery
+
+import (
+	"context"
+	"time"
+
+	pagingpb "github.com/DevisArya/learn-microservices-protorepo/pb/pagination"
+	transactionpb "github.com/DevisArya/learn-microservices-protorepo/pb/transaction"
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/usecase"
+	"github.com/DevisArya/learn-microservices/svckit/grpcserver"
+)
+
+type TransactionController interface {
+	transactionpb.TransactionServiceServer
+}
+
+type TransactionControllerImpl struct {
+	TransactionUc usecase.TransactionUseCase
+	transactionpb.UnimplementedTransactionServiceServer
+}
+
+func NewTransactionController(transactionUc usecase.TransactionUseCase) TransactionController {
+	return &TransactionControllerImpl{
+		TransactionUc: transactionUc,
+	}
+}
+
+func (controller *TransactionControllerImpl) CreateTransaction(ctx context.Context, req *transactionpb.CreateTransactionRequest) (*transactionpb.CreateTransactionResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleIds := make([]uint, 0, len(req.GetScheduleIds()))
+	for _, id := range req.GetScheduleIds() {
+		scheduleIds = append(scheduleIds, uint(id))
+	}
+
+	transaction, err := controller.TransactionUc.Save(ctx, caller, &dto.TransactionRequest{
+		ScheduleIds: scheduleIds,
+	})
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &transactionpb.CreateTransactionResponse{
+		Transaction: toTransactionPb(transaction),
+	}, nil
+}
+
+func (controller *TransactionControllerImpl) GetTransaction(ctx context.Context, req *transactionpb.Id) (*transactionpb.GetTransactionResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := controller.TransactionUc.FindById(ctx, caller, req.GetId())
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &transactionpb.GetTransactionResponse{
+		Transaction: toTransactionPb(transaction),
+	}, nil
+}
+
+func (controller *TransactionControllerImpl) ListTransactions(ctx context.Context, req *transactionpb.ListTransactionsRequest) (*transactionpb.ListTransactionsResponse, error) {
+
+	caller, err := callerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := dto.TransactionFilter{}
+	if req.UserId != nil {
+		userId := uint(req.GetUserId())
+		filter.UserId = &userId
+	}
+
+	res, paging, err := controller.TransactionUc.FindAll(ctx, caller, &filter, req.GetLimit(), req.GetPage())
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	var transactions []*transactionpb.Transaction
+	for _, t := range *res {
+		transactions = append(transactions, toTransactionPb(&t))
+	}
+
+	return &transactionpb.ListTransactionsResponse{
+		Pagination: &pagingpb.Pagination{
+			CurrentPage: paging.CurrentPage,
+			Limit:       paging.Limit,
+			TotalRecord: paging.TotalRecord,
+			TotalPage:   paging.TotalPage,
+		}, Data: transactions,
+	}, nil
+}
+
+func toTransactionPb(t *entity.Transaction) *transactionpb.Transaction {
+	transaction := &transactionpb.Transaction{
+		TransactionId:   t.TransactionId,
+		UserId:          uint32(t.UserId),
+		PaymentStatus:   t.PaymentStatus,
+		TotalPrice:      t.TotalPrice,
+		TransactionTime: t.TransactionTime.Format(time.RFC3339),
+	}
+	for _, d := range t.TransactionDetail {
+		transaction.Details = append(transaction.Details, &transactionpb.TransactionDetail{
+			Id:         uint32(d.Id),
+			ScheduleId: uint32(d.ScheduleId),
+			Name:       d.Name,
+			Price:      d.Price,
+		})
+	}
+	return transaction
+}
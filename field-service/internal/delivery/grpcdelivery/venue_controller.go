@@ -0,0 +1,172 @@
+package grpcdelivery
+
+import (
+	"context"
+
+	pagingpb "github.com/DevisArya/learn-microservices-protorepo/pb/pagination"
+	venuepb "github.com/DevisArya/learn-microservices-protorepo/pb/venue"
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/usecase"
+	"github.com/DevisArya/learn-microservices/svckit/grpcserver"
+)
+
+type VenueController interface {
+	venuepb.VenueServiceServer
+}
+
+type VenueControllerImpl struct {
+	VenueUc usecase.VenueUseCase
+	venuepb.UnimplementedVenueServiceServer
+}
+
+func NewVenueController(venueUc usecase.VenueUseCase) VenueController {
+	return &VenueControllerImpl{
+		VenueUc: venueUc,
+	}
+}
+
+func (controller *VenueControllerImpl) GetVenues(ctx context.Context, req *venuepb.GetVenuesRequest) (*venuepb.GetVenuesResponse, error) {
+
+	res, paging, err := controller.VenueUc.FindAll(ctx, req.GetLimit(), req.GetPage())
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	var venues []*venuepb.Venue
+	for _, v := range *res {
+		venues = append(venues, toVenuePb(&v))
+	}
+
+	return &venuepb.GetVenuesResponse{
+		Pagination: &pagingpb.Pagination{
+			CurrentPage: paging.CurrentPage,
+			Limit:       paging.Limit,
+			TotalRecord: paging.TotalRecord,
+			TotalPage:   paging.TotalPage,
+		}, Data: venues,
+	}, nil
+}
+
+func (controller *VenueControllerImpl) GetVenue(ctx context.Context, req *venuepb.Id) (*venuepb.GetVenueResponse, error) {
+
+	res, err := controller.VenueUc.FindById(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &venuepb.GetVenueResponse{
+		Venue: toVenuePb(res),
+	}, nil
+}
+
+func (controller *VenueControllerImpl) CreateVenue(ctx context.Context, req *venuepb.CreateVenueRequest) (*venuepb.CreateVenueResponse, error) {
+
+	if err := requireSuperUser(ctx); err != nil {
+		return nil, err
+	}
+
+	venueReq := dto.VenueRequest{
+		Name:         req.GetName(),
+		Address:      req.GetAddress(),
+		Latitude:     req.GetLatitude(),
+		Longitude:    req.GetLongitude(),
+		PhoneNumber:  req.GetPhoneNumber(),
+		Email:        req.GetEmail(),
+		OpeningHours: req.GetOpeningHours(),
+		Timezone:     req.GetTimezone(),
+	}
+	venue, err := controller.VenueUc.Save(ctx, &venueReq)
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &venuepb.CreateVenueResponse{
+		Id:      uint32(venue.Id),
+		Message: "Create venue succesfully",
+	}, nil
+}
+
+func (controller *VenueControllerImpl) UpdateVenue(ctx context.Context, req *venuepb.UpdateVenueRequest) (*venuepb.StatusResponse, error) {
+
+	if err := requireSuperUser(ctx); err != nil {
+		return nil, err
+	}
+
+	venueReq := dto.VenueRequest{
+		Name:         req.GetName(),
+		Address:      req.GetAddress(),
+		Latitude:     req.GetLatitude(),
+		Longitude:    req.GetLongitude(),
+		PhoneNumber:  req.GetPhoneNumber(),
+		Email:        req.GetEmail(),
+		OpeningHours: req.GetOpeningHours(),
+		Timezone:     req.GetTimezone(),
+	}
+
+	if err := controller.VenueUc.Update(ctx, &venueReq, uint(req.GetId())); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &venuepb.StatusResponse{
+		Message: "Success update",
+	}, nil
+}
+
+func (controller *VenueControllerImpl) DeleteVenue(ctx context.Context, req *venuepb.Id) (*venuepb.StatusResponse, error) {
+
+	if err := requireSuperUser(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := controller.VenueUc.Delete(ctx, uint(req.GetId())); err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	return &venuepb.StatusResponse{
+		Message: "Success delete",
+	}, nil
+}
+
+func (controller *VenueControllerImpl) GetNearestVenues(ctx context.Context, req *venuepb.GetNearestVenuesRequest) (*venuepb.GetNearestVenuesResponse, error) {
+
+	res, err := controller.VenueUc.FindNearest(ctx, &dto.NearestVenueRequest{
+		Latitude:      req.GetLatitude(),
+		Longitude:     req.GetLongitude(),
+		Limit:         req.GetLimit(),
+		MaxDistanceKm: req.GetMaxDistanceKm(),
+	})
+	if err != nil {
+		return nil, grpcserver.Error(err)
+	}
+
+	var venues []*venuepb.VenueDistance
+	for _, v := range *res {
+		venues = append(venues, &venuepb.VenueDistance{
+			Venue:      toVenuePb(&v.Venue),
+			DistanceKm: v.DistanceKm,
+		})
+	}
+
+	return &venuepb.GetNearestVenuesResponse{
+		Data: venues,
+	}, nil
+}
+
+func toVenuePb(v *entity.Venue) *venuepb.Venue {
+	venue := &venuepb.Venue{
+		Id:           uint32(v.Id),
+		Name:         v.Name,
+		Address:      v.Address,
+		Latitude:     v.Latitude,
+		Longitude:    v.Longitude,
+		PhoneNumber:  v.PhoneNumber,
+		Email:        v.Email,
+		OpeningHours: v.OpeningHours,
+		Timezone:     v.Timezone,
+	}
+	for _, f := range v.Fields {
+		venue.FieldIds = append(venue.FieldIds, uint32(f.Id))
+	}
+	return venue
+}
@@ -0,0 +1,56 @@
+package grpcdelivery
+
+import (
+	"context"
+	"testing"
+
+	venuepb "github.com/DevisArya/learn-microservices-protorepo/pb/venue"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestVenueMutationsRequireSuperUser(t *testing.T) {
+	// the use case is never reached, a nil one would panic if it were
+	controller := NewVenueController(nil)
+
+	callerContext := func(role entity.Role) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(metadataUserId, "7", metadataRole, string(role)))
+	}
+
+	mutations := map[string]func(ctx context.Context) error{
+		"create": func(ctx context.Context) error {
+			_, err := controller.CreateVenue(ctx, &venuepb.CreateVenueRequest{Name: "arena", Address: "main street"})
+			return err
+		},
+		"update": func(ctx context.Context) error {
+			_, err := controller.UpdateVenue(ctx, &venuepb.UpdateVenueRequest{Id: 1, Name: "arena", Address: "main street"})
+			return err
+		},
+		"delete": func(ctx context.Context) error {
+			_, err := controller.DeleteVenue(ctx, &venuepb.Id{Id: 1})
+			return err
+		},
+	}
+
+	callers := []struct {
+		name string
+		ctx  context.Context
+		code codes.Code
+	}{
+		{"anonymous", context.Background(), codes.Unauthenticated},
+		{"user", callerContext(entity.RoleUser), codes.PermissionDenied},
+		{"operator", callerContext(entity.RoleOperator), codes.PermissionDenied},
+	}
+
+	for name, mutate := range mutations {
+		for _, caller := range callers {
+			t.Run(name+" by "+caller.name, func(t *testing.T) {
+				if got := status.Code(mutate(caller.ctx)); got != caller.code {
+					t.Errorf("code = %s, want %s", got, caller.code)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,7 @@
+package dto
+
+// Caller identifies the user behind a gRPC request.
+type Caller struct {
+	UserId uint
+	Role   string
+}
@@ -1,10 +1,19 @@
 package dto
 
+import "time"
+
 type FieldRequest struct {
 	Name        string `json:"Name" form:"Name" validate:"required"`
 	Type        string `json:"Type" form:"Type" validate:"required,min=3,max=50"`
 	Description string `json:"Description" form:"Description" validate:"required,max=50"`
 	Price       uint32 `json:"Price" form:"Price" valdiate:"required,gt=0"`
+	VenueId     *uint  `json:"VenueId" form:"VenueId"`
+	OperatorId  *uint  `json:"OperatorId" form:"OperatorId"`
+	ParentId    *uint  `json:"ParentId" form:"ParentId"`
+	Timezone    string `json:"Timezone" form:"Timezone" validate:"omitempty,ne=Local,timezone"`
+	MinDuration uint32 `json:"MinDuration" form:"MinDuration" validate:"lte=1440"`
+	MaxDuration uint32 `json:"MaxDuration" form:"MaxDuration" validate:"lte=1440"`
+	Granularity uint32 `json:"Granularity" form:"Granularity" validate:"lte=1440"`
 }
 
 type DtoField struct {
@@ -14,3 +23,52 @@ type DtoField struct {
 	Description string `valdiate:"required"`
 	Price       uint32 `valdiate:"required,gt=0"`
 }
+
+type FieldFilter struct {
+	Type      string `validate:"max=50"`
+	MinPrice  *uint32
+	MaxPrice  *uint32
+	Search    string `validate:"max=100"`
+	SortBy    string `validate:"omitempty,oneof=price name created_at rating"`
+	SortOrder string `validate:"omitempty,oneof=asc desc"`
+}
+
+type AvailabilityFilter struct {
+	StartDate time.Time `validate:"required"`
+	EndDate   time.Time `validate:"required,gtfield=StartDate"`
+	Type      string    `validate:"max=50"`
+	MinPrice  *uint32
+	MaxPrice  *uint32
+	MinSlots  uint32
+}
+
+const (
+	ImportModeAllOrNothing = "all_or_nothing"
+	ImportModeBestEffort   = "best_effort"
+)
+
+// FieldImportRow is one decoded row of a bulk import. Row is its 1-based line
+// in the uploaded file, Err is set when the row could not be decoded.
+type FieldImportRow struct {
+	Row     uint32
+	Request FieldRequest
+	Err     error
+}
+
+type FieldImportRequest struct {
+	Mode string `validate:"required,oneof=all_or_nothing best_effort"`
+	Rows []FieldImportRow
+}
+
+type FieldImportError struct {
+	Row     uint32
+	Field   string
+	Rule    string
+	Message string
+}
+
+type FieldImportResult struct {
+	TotalRows uint32
+	Ids       []uint
+	Errors    []FieldImportError
+}
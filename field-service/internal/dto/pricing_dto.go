@@ -0,0 +1,30 @@
+package dto
+
+import "time"
+
+type PricingRuleRequest struct {
+	FieldId    uint    `json:"FieldId" form:"FieldId" validate:"required"`
+	Name       string  `json:"Name" form:"Name" validate:"required,max=100"`
+	Kind       string  `json:"Kind" form:"Kind" validate:"required,oneof=surcharge override"`
+	Weekdays   []uint8 `json:"Weekdays" form:"Weekdays" validate:"dive,min=0,max=6"`
+	StartTime  string  `json:"StartTime" form:"StartTime" validate:"required_with=EndTime,omitempty,datetime=15:04"`
+	EndTime    string  `json:"EndTime" form:"EndTime" validate:"required_with=StartTime,omitempty,datetime=15:04"`
+	ValidFrom  *time.Time
+	ValidUntil *time.Time
+	Percent    int32  `json:"Percent" form:"Percent" validate:"min=-99,max=1000"`
+	Price      uint32 `json:"Price" form:"Price"`
+	Priority   int32  `json:"Priority" form:"Priority"`
+}
+
+type PriceLine struct {
+	Description string
+	Amount      int64
+}
+
+type PriceQuote struct {
+	ScheduleId uint
+	FieldId    uint
+	Date       time.Time
+	Lines      []PriceLine
+	Total      uint32
+}
@@ -0,0 +1,7 @@
+package dto
+
+type ReviewRequest struct {
+	ScheduleId uint   `json:"ScheduleId" form:"ScheduleId" validate:"required"`
+	Rating     uint8  `json:"Rating" form:"Rating" validate:"required,min=1,max=5"`
+	Comment    string `json:"Comment" form:"Comment" validate:"max=2000"`
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+)
+
+type ScheduleRequest struct {
+	FieldId uint      `json:"FieldId" form:"FieldId" validate:"required"`
+	Date    time.Time `json:"Date" form:"Date" validate:"required"`
+	EndDate time.Time `json:"EndDate" form:"EndDate" validate:"required,gtfield=Date"`
+}
+
+type ScheduleStatusRequest struct {
+	Status string `json:"Status" form:"Status" validate:"required,oneof=available reserved sold"`
+	UserId *uint  `json:"UserId" form:"UserId"`
+}
+
+type ScheduleFilter struct {
+	FieldId   uint      `validate:"required"`
+	StartDate time.Time `validate:"required"`
+	EndDate   time.Time `validate:"required,gtfield=StartDate"`
+}
+
+type ScheduleTemplateRequest struct {
+	FieldId      uint   `json:"FieldId" form:"FieldId" validate:"required"`
+	Weekday      uint8  `json:"Weekday" form:"Weekday" validate:"min=0,max=6"`
+	OpenTime     string `json:"OpenTime" form:"OpenTime" validate:"required,datetime=15:04"`
+	CloseTime    string `json:"CloseTime" form:"CloseTime" validate:"required,datetime=15:04"`
+	SlotDuration uint32 `json:"SlotDuration" form:"SlotDuration" validate:"required,gt=0,lte=1440"`
+}
+
+type BlackoutRequest struct {
+	FieldId uint      `json:"FieldId" form:"FieldId" validate:"required"`
+	StartAt time.Time `json:"StartAt" form:"StartAt" validate:"required"`
+	EndAt   time.Time `json:"EndAt" form:"EndAt" validate:"required,gtfield=StartAt"`
+	Reason  string    `json:"Reason" form:"Reason" validate:"max=255"`
+	Force   bool      `json:"Force" form:"Force"`
+}
+
+// ScheduleEvent is a status change of a schedule slot. Seq orders events of
+// one broadcaster and is assigned when the event is published.
+type ScheduleEvent struct {
+	Seq            uint64
+	Schedule       entity.Schedule
+	PreviousStatus entity.ScheduleStatus
+	OccurredAt     time.Time
+}
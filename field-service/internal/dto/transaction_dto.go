@@ -0,0 +1,9 @@
+package dto
+
+type TransactionRequest struct {
+	ScheduleIds []uint `json:"ScheduleIds" form:"ScheduleIds" validate:"required,min=1,max=20,unique,dive,required"`
+}
+
+type TransactionFilter struct {
+	UserId *uint
+}
@@ -0,0 +1,26 @@
+package dto
+
+import "github.com/DevisArya/learn-microservices/field-service/internal/entity"
+
+type VenueRequest struct {
+	Name         string  `json:"Name" form:"Name" validate:"required,max=100"`
+	Address      string  `json:"Address" form:"Address" validate:"required"`
+	Latitude     float64 `json:"Latitude" form:"Latitude" validate:"min=-90,max=90"`
+	Longitude    float64 `json:"Longitude" form:"Longitude" validate:"min=-180,max=180"`
+	PhoneNumber  string  `json:"PhoneNumber" form:"PhoneNumber" validate:"omitempty,min=8,max=20,numeric"`
+	Email        string  `json:"Email" form:"Email" validate:"omitempty,email,max=255"`
+	OpeningHours string  `json:"OpeningHours" form:"OpeningHours" validate:"max=255"`
+	Timezone     string  `json:"Timezone" form:"Timezone" validate:"omitempty,ne=Local,timezone"`
+}
+
+type NearestVenueRequest struct {
+	Latitude      float64 `validate:"min=-90,max=90"`
+	Longitude     float64 `validate:"min=-180,max=180"`
+	Limit         uint32
+	MaxDistanceKm float64 `validate:"min=0"`
+}
+
+type VenueDistance struct {
+	Venue      entity.Venue
+	DistanceKm float64
+}
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// Blackout closes a field between StartAt (inclusive) and EndAt (exclusive),
+// e.g. for maintenance or a private event. Schedules overlapping the window
+// can not be booked.
+type Blackout struct {
+	Id        uint      `gorm:"primaryKey"`
+	FieldId   uint      `gorm:"not null;index:idx_blackout_field_window"`
+	StartAt   time.Time `gorm:"not null;index:idx_blackout_field_window"`
+	EndAt     time.Time `gorm:"not null"`
+	Reason    string    `gorm:"size:255"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	Field Field `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// Overlaps reports whether the blackout window intersects [start, end).
+func (blackout *Blackout) Overlaps(start time.Time, end time.Time) bool {
+	return start.Before(blackout.EndAt) && end.After(blackout.StartAt)
+}
@@ -1,11 +1,101 @@
 package entity
 
+import (
+	"time"
+
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	"gorm.io/gorm"
+)
+
+// Booking bounds used when a field does not set its own, in minutes.
+const (
+	DefaultMinDuration uint32 = 60
+	DefaultMaxDuration uint32 = 240
+	DefaultGranularity uint32 = 30
+)
+
 type Field struct {
-	Id          uint   `gorm:"primary_key"`
-	Name        string `gorm:"size:100;not null"`
-	Type        string `gorm:"size:50;not null"`
-	Description string `gorm:"type:text"`
-	Price       uint32 `gorm:"not null"`
+	Id          uint           `gorm:"primary_key"`
+	VenueId     *uint          `gorm:"index"`
+	OperatorId  *uint          `gorm:"index"`
+	Name        string         `gorm:"size:100;not null"`
+	Type        string         `gorm:"size:50;not null"`
+	Description string         `gorm:"type:text"`
+	Price       uint32         `gorm:"not null"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
+
+	// RatingAverage and RatingCount summarize the field reviews, kept in
+	// sync by the review use case so fields can be sorted by rating.
+	RatingAverage float64 `gorm:"not null;default:0;index"`
+	RatingCount   uint32  `gorm:"not null;default:0"`
+
+	// ParentId is set on a part of a divisible field, e.g. one half of a
+	// court. Booking a part blocks its parent for that time and booking the
+	// parent blocks all of its parts; parts do not block each other.
+	ParentId *uint   `gorm:"index"`
+	Parent   *Field  `gorm:"foreignKey:ParentId;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Parts    []Field `gorm:"foreignKey:ParentId"`
+
+	// Timezone is the IANA zone the field operates in. Schedules are stored
+	// in UTC and only read in this zone for slot generation, pricing rules
+	// and output.
+	Timezone string `gorm:"size:64;not null;default:'UTC'"`
+
+	// MinDuration, MaxDuration and Granularity bound a booking in minutes: it
+	// lasts between MinDuration and MaxDuration, and both its local start and
+	// its length are a multiple of Granularity.
+	MinDuration uint32 `gorm:"not null;default:60"`
+	MaxDuration uint32 `gorm:"not null;default:240"`
+	Granularity uint32 `gorm:"not null;default:30"`
 
 	Schedule []Schedule `gorm:"foreignKey:FieldId"`
+	Venue    *Venue     `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Operator *User      `gorm:"foreignKey:OperatorId;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+}
+
+// Location returns the field time zone, UTC when it is unset or unknown.
+func (f *Field) Location() *time.Location {
+	return LoadLocation(f.Timezone)
+}
+
+// CheckBooking returns an error when [start, end) is not a booking the field
+// accepts under its duration bounds and granularity.
+func (f *Field) CheckBooking(start time.Time, end time.Time) error {
+	if !end.After(start) {
+		return apperror.InvalidArgument("end must be after start")
+	}
+
+	duration := end.Sub(start)
+	if duration%time.Minute != 0 {
+		return apperror.InvalidArgument("duration must be a whole number of minutes")
+	}
+
+	minutes := uint32(duration / time.Minute)
+	if f.Granularity > 0 && minutes%f.Granularity != 0 {
+		return apperror.InvalidArgument("duration must be a multiple of %d minutes", f.Granularity)
+	}
+	if minutes < f.MinDuration {
+		return apperror.InvalidArgument("duration must be at least %d minutes", f.MinDuration)
+	}
+	if f.MaxDuration > 0 && minutes > f.MaxDuration {
+		return apperror.InvalidArgument("duration must be at most %d minutes", f.MaxDuration)
+	}
+
+	local := start.In(f.Location())
+	if local.Second() != 0 || local.Nanosecond() != 0 {
+		return apperror.InvalidArgument("start must be on a whole minute")
+	}
+	if f.Granularity > 0 && uint32(local.Hour()*60+local.Minute())%f.Granularity != 0 {
+		return apperror.InvalidArgument("start must be aligned to %d minutes", f.Granularity)
+	}
+
+	return nil
+}
+
+// ProratedPrice returns the price of a booking lasting duration at hourly, the
+// price of one hour, rounded to the nearest unit.
+func ProratedPrice(hourly uint32, duration time.Duration) uint32 {
+	minutes := uint64(duration / time.Minute)
+	return uint32((uint64(hourly)*minutes + 30) / 60)
 }
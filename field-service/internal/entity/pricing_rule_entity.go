@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+type PricingRuleKind string
+
+const (
+	PricingRuleSurcharge PricingRuleKind = "surcharge"
+	PricingRuleOverride  PricingRuleKind = "override"
+)
+
+type PricingRule struct {
+	Id         uint            `gorm:"primaryKey"`
+	FieldId    uint            `gorm:"not null;index"`
+	Name       string          `gorm:"size:100;not null"`
+	Kind       PricingRuleKind `gorm:"type:enum('surcharge', 'override')"`
+	Weekdays   uint8           `gorm:"not null"`
+	StartTime  string          `gorm:"size:5"`
+	EndTime    string          `gorm:"size:5"`
+	ValidFrom  *time.Time      `gorm:"type:datetime;null"`
+	ValidUntil *time.Time      `gorm:"type:datetime;null"`
+	Percent    int32           `gorm:"not null"`
+	Price      uint32          `gorm:"not null"`
+	Priority   int32           `gorm:"not null"`
+
+	Field Field `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
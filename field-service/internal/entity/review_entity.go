@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type Review struct {
+	Id         uint      `gorm:"primaryKey"`
+	FieldId    uint      `gorm:"not null;index"`
+	ScheduleId uint      `gorm:"not null;uniqueIndex"`
+	UserId     uint      `gorm:"not null;index"`
+	Rating     uint8     `gorm:"not null"`
+	Comment    string    `gorm:"type:text"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+
+	Field    Field    `gorm:"constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	Schedule Schedule `gorm:"constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	User     User     `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -12,14 +12,30 @@ const (
 	ScheduleStatusSold      ScheduleStatus = "sold"
 )
 
+// Schedule is a bookable period of a field from Date (inclusive) to EndDate
+// (exclusive).
 type Schedule struct {
 	Id                uint              `gorm:"primaryKey"`
-	UserId            uint              `gorm:"not null"`
-	FieldId           uint              `gorm:"not null"`
-	Date              time.Time         `gorm:"not null"`
+	UserId            *uint             `gorm:"null"`
+	FieldId           uint              `gorm:"not null;uniqueIndex:idx_schedule_field_date"`
+	Date              time.Time         `gorm:"not null;uniqueIndex:idx_schedule_field_date"`
+	EndDate           time.Time         `gorm:"not null;index"`
 	Status            ScheduleStatus    `gorm:"type:enum('available', 'reserved', 'sold')"`
+	HoldExpiresAt     *time.Time        `gorm:"null;index"`
+	Version           uint              `gorm:"not null;default:0"`
+	Generated         bool              `gorm:"not null;default:false"`
 	TransactionDetail TransactionDetail `gorm:"foreignKey:ScheduleId"`
 
 	User  User  `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
-	Field Field `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	Field Field `gorm:"constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+}
+
+// Duration returns the length of the schedule.
+func (s *Schedule) Duration() time.Duration {
+	return s.EndDate.Sub(s.Date)
+}
+
+// Overlaps reports whether the schedule intersects [start, end).
+func (s *Schedule) Overlaps(start time.Time, end time.Time) bool {
+	return start.Before(s.EndDate) && end.After(s.Date)
 }
@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type ScheduleTemplate struct {
+	Id           uint         `gorm:"primaryKey"`
+	FieldId      uint         `gorm:"not null;index"`
+	Weekday      time.Weekday `gorm:"not null"`
+	OpenTime     string       `gorm:"size:5;not null"`
+	CloseTime    string       `gorm:"size:5;not null"`
+	SlotDuration uint32       `gorm:"not null"`
+
+	Field Field `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
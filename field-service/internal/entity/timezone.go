@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"sync"
+	"time"
+)
+
+var locations sync.Map
+
+// LoadLocation returns the IANA time zone called name, UTC when name is empty
+// or unknown. Loaded zones are cached since every schedule conversion needs one.
+func LoadLocation(name string) *time.Location {
+	if name == "" {
+		return time.UTC
+	}
+
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+
+	locations.Store(name, loc)
+	return loc
+}
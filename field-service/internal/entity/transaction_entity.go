@@ -10,6 +10,10 @@ const (
 	PaymentStatusUnpaid  PaymentStatus = "unpaid"
 	PaymentRejected      PaymentStatus = "rejected"
 	PaymentStatusSuccess PaymentStatus = "success"
+
+	// PaymentStatusExpired marks an unpaid transaction whose slot holds ran
+	// out and were released by the sweeper.
+	PaymentStatusExpired PaymentStatus = "expired"
 )
 
 type Transaction struct {
@@ -19,7 +23,7 @@ type Transaction struct {
 	OrderId           string              `gorm:"size:100"`
 	PaymentType       string              `gorm:"size:100"`
 	PaymentUrl        string              `gorm:"size:255"`
-	PaymentStatus     string              `gorm:"type:enum('unpaid', 'rejected', 'success')"`
+	PaymentStatus     string              `gorm:"type:enum('unpaid', 'rejected', 'success', 'expired')"`
 	TotalPrice        uint32              `gorm:"not null"`
 	TransactionTime   time.Time           `gorm:"type:datetime;null"`
 	SettlementTime    time.Time           `gorm:"type:datetime;null"`
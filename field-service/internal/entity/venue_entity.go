@@ -0,0 +1,15 @@
+package entity
+
+type Venue struct {
+	Id           uint    `gorm:"primaryKey"`
+	Name         string  `gorm:"size:100;not null"`
+	Address      string  `gorm:"type:text;not null"`
+	Latitude     float64 `gorm:"not null;index:idx_venue_location"`
+	Longitude    float64 `gorm:"not null;index:idx_venue_location"`
+	PhoneNumber  string  `gorm:"size:20"`
+	Email        string  `gorm:"size:255"`
+	OpeningHours string  `gorm:"size:255"`
+	Timezone     string  `gorm:"size:64;not null;default:'UTC'"`
+
+	Fields []Field `gorm:"foreignKey:VenueId"`
+}
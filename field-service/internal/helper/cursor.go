@@ -0,0 +1,30 @@
+package helper
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+)
+
+func EncodeCursor(cursor *dto.Cursor) string {
+	data, err := json.Marshal(cursor)
+	PanicIfError(err)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(token string) (*dto.Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, apperror.Violation("Cursor", "invalid cursor")
+	}
+
+	var cursor dto.Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, apperror.Violation("Cursor", "invalid cursor")
+	}
+
+	return &cursor, nil
+}
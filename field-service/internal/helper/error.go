@@ -1,5 +1,20 @@
 package helper
 
+import "github.com/DevisArya/learn-microservices/svckit/apperror"
+
+var (
+	ErrPermissionDenied = apperror.PermissionDenied("permission denied")
+	ErrBlackedOut       = apperror.FailedPrecondition("field is closed at that time")
+	ErrBlackoutConflict = apperror.FailedPrecondition("blackout overlaps sold schedules")
+	ErrHoldExpired      = apperror.FailedPrecondition("schedule hold is no longer active")
+	ErrScheduleTaken    = apperror.FailedPrecondition("schedule is already taken")
+	ErrFieldPartBooked  = apperror.FailedPrecondition("an overlapping part of the field is already booked")
+	ErrScheduleOverlap  = apperror.FailedPrecondition("schedule overlaps an existing schedule of the field")
+	ErrConcurrentUpdate = apperror.Aborted("schedule was changed concurrently, retry")
+	ErrNotReviewable    = apperror.FailedPrecondition("only a played booking can be reviewed")
+	ErrAlreadyReviewed  = apperror.AlreadyExists("booking already reviewed")
+)
+
 func PanicIfError(err error) {
 	if err != nil {
 		panic(err)
@@ -0,0 +1,37 @@
+package helper
+
+import "math"
+
+const earthRadiusKm = 6371.0
+
+// Haversine returns the great-circle distance in kilometres between two points
+// given in decimal degrees.
+func Haversine(lat1, lng1, lat2, lng2 float64) float64 {
+	dLat := (lat2 - lat1) * math.Pi / 180
+	dLng := (lng2 - lng1) * math.Pi / 180
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*math.Pi/180)*math.Cos(lat2*math.Pi/180)*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// BoundingBox returns the latitude and longitude ranges that contain every
+// point within radiusKm of the given point.
+func BoundingBox(lat, lng, radiusKm float64) (minLat, maxLat, minLng, maxLng float64) {
+	dLat := radiusKm / earthRadiusKm * 180 / math.Pi
+	minLat, maxLat = math.Max(lat-dLat, -90), math.Min(lat+dLat, 90)
+
+	//near the poles every longitude is in range
+	if minLat == -90 || maxLat == 90 {
+		return minLat, maxLat, -180, 180
+	}
+
+	dLng := dLat / math.Cos(lat*math.Pi/180)
+	minLng, maxLng = lng-dLng, lng+dLng
+	if minLng < -180 || maxLng > 180 {
+		return minLat, maxLat, -180, 180
+	}
+
+	return minLat, maxLat, minLng, maxLng
+}
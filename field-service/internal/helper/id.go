@@ -0,0 +1,18 @@
+package helper
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// NewTransactionId returns a random transaction id such as
+// "TRX-8f14e45fceea167a5a36dedd4bea25", short enough for the 35 character
+// primary key.
+func NewTransactionId() (string, error) {
+	b := make([]byte, 15)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return "TRX-" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/cache"
+)
+
+// CacheReporter logs the hit and miss counts of a cache, and the hit ratio
+// since the previous report.
+type CacheReporter struct {
+	Name     string
+	Stats    func() cache.Stats
+	Interval time.Duration
+	last     cache.Stats
+}
+
+func NewCacheReporter(name string, stats func() cache.Stats, interval time.Duration) *CacheReporter {
+	return &CacheReporter{
+		Name:     name,
+		Stats:    stats,
+		Interval: interval,
+	}
+}
+
+// Start reports on every interval until ctx is done.
+func (job *CacheReporter) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(job.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				job.run()
+			}
+		}
+	}()
+}
+
+func (job *CacheReporter) run() {
+	stats := job.Stats()
+	window := cache.Stats{
+		Hits:   stats.Hits - job.last.Hits,
+		Misses: stats.Misses - job.last.Misses,
+	}
+	job.last = stats
+
+	if window.Hits+window.Misses == 0 {
+		return
+	}
+
+	log.Printf("%s cache: %d hits, %d misses, hit ratio %.2f (total %d hits, %d misses, hit ratio %.2f)",
+		job.Name, window.Hits, window.Misses, window.HitRatio(), stats.Hits, stats.Misses, stats.HitRatio())
+}
@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/usecase"
+)
+
+type FieldPurger struct {
+	FieldUc   usecase.FieldUseCase
+	Retention time.Duration
+	Interval  time.Duration
+}
+
+func NewFieldPurger(fieldUc usecase.FieldUseCase, retention time.Duration, interval time.Duration) *FieldPurger {
+	return &FieldPurger{
+		FieldUc:   fieldUc,
+		Retention: retention,
+		Interval:  interval,
+	}
+}
+
+// Start runs the purger once and then on every interval until ctx is done.
+func (job *FieldPurger) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(job.Interval)
+		defer ticker.Stop()
+
+		for {
+			job.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (job *FieldPurger) run(ctx context.Context) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("field purger: %v", err)
+		}
+	}()
+
+	purged, err := job.FieldUc.Purge(ctx, job.Retention)
+	if err != nil {
+		log.Printf("field purger: %v", err)
+		return
+	}
+
+	if purged > 0 {
+		log.Printf("field purger: purged %d fields", purged)
+	}
+}
@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/usecase"
+)
+
+// HoldSweeper returns reserved slots whose hold expired to available. Every
+// replica may run one; each release is a compare-and-swap on the slot version.
+type HoldSweeper struct {
+	ScheduleUc usecase.ScheduleUseCase
+	Interval   time.Duration
+}
+
+func NewHoldSweeper(scheduleUc usecase.ScheduleUseCase, interval time.Duration) *HoldSweeper {
+	return &HoldSweeper{
+		ScheduleUc: scheduleUc,
+		Interval:   interval,
+	}
+}
+
+// Start runs the sweeper once and then on every interval until ctx is done.
+func (job *HoldSweeper) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(job.Interval)
+		defer ticker.Stop()
+
+		for {
+			job.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (job *HoldSweeper) run(ctx context.Context) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("hold sweeper: %v", err)
+		}
+	}()
+
+	released, err := job.ScheduleUc.ReleaseExpiredHolds(ctx)
+	if err != nil {
+		log.Printf("hold sweeper: %v", err)
+		return
+	}
+
+	if released > 0 {
+		log.Printf("hold sweeper: released %d schedules", released)
+	}
+}
@@ -0,0 +1,53 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/usecase"
+)
+
+type ScheduleGenerator struct {
+	ScheduleTemplateUc usecase.ScheduleTemplateUseCase
+	Weeks              uint32
+	Interval           time.Duration
+}
+
+func NewScheduleGenerator(scheduleTemplateUc usecase.ScheduleTemplateUseCase, weeks uint32, interval time.Duration) *ScheduleGenerator {
+	return &ScheduleGenerator{
+		ScheduleTemplateUc: scheduleTemplateUc,
+		Weeks:              weeks,
+		Interval:           interval,
+	}
+}
+
+// Start runs the generator once and then on every interval until ctx is done.
+func (job *ScheduleGenerator) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(job.Interval)
+		defer ticker.Stop()
+
+		for {
+			job.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (job *ScheduleGenerator) run(ctx context.Context) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("schedule generator: %v", err)
+		}
+	}()
+
+	if err := job.ScheduleTemplateUc.Generate(ctx, job.Weeks); err != nil {
+		log.Printf("schedule generator: %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package migration
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+
+	migrationkit "github.com/DevisArya/learn-microservices/svckit/migration"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrator, err := NewMigrator(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sqlFiles, err := fs.Glob(files, "sql/*.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sqlFiles) != 2*len(migrator.Migrations) {
+		t.Errorf("%d files hold %d migrations, want an up and a down file each", len(sqlFiles), len(migrator.Migrations))
+	}
+
+	for i, migration := range migrator.Migrations {
+		//versions are applied in order, a gap would hide a missing file
+		if want := uint64(i + 1); migration.Version != want {
+			t.Errorf("migration %d has version %d, want %d", i, migration.Version, want)
+		}
+
+		for direction, script := range map[string]string{"up": migration.Up, "down": migration.Down} {
+			statements := migrationkit.Statements(script)
+			if len(statements) == 0 {
+				t.Errorf("%04d_%s %s has no statements", migration.Version, migration.Name, direction)
+			}
+			for _, statement := range statements {
+				if !strings.HasSuffix(statement, ";") {
+					t.Errorf("%04d_%s %s: statement %q does not end with a semicolon", migration.Version, migration.Name, direction, statement)
+				}
+			}
+		}
+	}
+}
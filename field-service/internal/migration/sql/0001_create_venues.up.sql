@@ -0,0 +1,13 @@
+CREATE TABLE venues (
+	id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	name VARCHAR(100) NOT NULL,
+	address TEXT NOT NULL,
+	latitude DOUBLE NOT NULL,
+	longitude DOUBLE NOT NULL,
+	phone_number VARCHAR(20),
+	email VARCHAR(255),
+	opening_hours VARCHAR(255),
+	timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+	PRIMARY KEY (id),
+	INDEX idx_venue_location (latitude, longitude)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,27 @@
+-- operator_id refers to users of the user service and is not enforced here.
+CREATE TABLE fields (
+	id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	venue_id BIGINT UNSIGNED NULL,
+	operator_id BIGINT UNSIGNED NULL,
+	name VARCHAR(100) NOT NULL,
+	type VARCHAR(50) NOT NULL,
+	description TEXT,
+	price INT UNSIGNED NOT NULL,
+	created_at DATETIME(3),
+	deleted_at DATETIME(3) NULL,
+	rating_average DOUBLE NOT NULL DEFAULT 0,
+	rating_count INT UNSIGNED NOT NULL DEFAULT 0,
+	parent_id BIGINT UNSIGNED NULL,
+	timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+	min_duration INT UNSIGNED NOT NULL DEFAULT 60,
+	max_duration INT UNSIGNED NOT NULL DEFAULT 240,
+	granularity INT UNSIGNED NOT NULL DEFAULT 30,
+	PRIMARY KEY (id),
+	INDEX idx_fields_venue_id (venue_id),
+	INDEX idx_fields_operator_id (operator_id),
+	INDEX idx_fields_deleted_at (deleted_at),
+	INDEX idx_fields_rating_average (rating_average),
+	INDEX idx_fields_parent_id (parent_id),
+	CONSTRAINT fk_fields_venue FOREIGN KEY (venue_id) REFERENCES venues (id) ON UPDATE CASCADE ON DELETE SET NULL,
+	CONSTRAINT fk_fields_parent FOREIGN KEY (parent_id) REFERENCES fields (id) ON UPDATE CASCADE ON DELETE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,16 @@
+-- user_id refers to users of the user service and is not enforced here.
+CREATE TABLE schedules (
+	id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	user_id BIGINT UNSIGNED NULL,
+	field_id BIGINT UNSIGNED NOT NULL,
+	date DATETIME(3) NOT NULL,
+	end_date DATETIME(3) NOT NULL,
+	status ENUM('available', 'reserved', 'sold'),
+	hold_expires_at DATETIME(3) NULL,
+	version BIGINT UNSIGNED NOT NULL DEFAULT 0,
+	PRIMARY KEY (id),
+	UNIQUE INDEX idx_schedule_field_date (field_id, date),
+	INDEX idx_schedules_end_date (end_date),
+	INDEX idx_schedules_hold_expires_at (hold_expires_at),
+	CONSTRAINT fk_fields_schedule FOREIGN KEY (field_id) REFERENCES fields (id) ON UPDATE CASCADE ON DELETE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
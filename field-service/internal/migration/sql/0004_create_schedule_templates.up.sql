@@ -0,0 +1,11 @@
+CREATE TABLE schedule_templates (
+	id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	field_id BIGINT UNSIGNED NOT NULL,
+	weekday BIGINT NOT NULL,
+	open_time VARCHAR(5) NOT NULL,
+	close_time VARCHAR(5) NOT NULL,
+	slot_duration INT UNSIGNED NOT NULL,
+	PRIMARY KEY (id),
+	INDEX idx_schedule_templates_field_id (field_id),
+	CONSTRAINT fk_schedule_templates_field FOREIGN KEY (field_id) REFERENCES fields (id) ON UPDATE CASCADE ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,11 @@
+CREATE TABLE blackouts (
+	id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	field_id BIGINT UNSIGNED NOT NULL,
+	start_at DATETIME(3) NOT NULL,
+	end_at DATETIME(3) NOT NULL,
+	reason VARCHAR(255),
+	created_at DATETIME(3),
+	PRIMARY KEY (id),
+	INDEX idx_blackout_field_window (field_id, start_at),
+	CONSTRAINT fk_blackouts_field FOREIGN KEY (field_id) REFERENCES fields (id) ON UPDATE CASCADE ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,17 @@
+CREATE TABLE pricing_rules (
+	id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	field_id BIGINT UNSIGNED NOT NULL,
+	name VARCHAR(100) NOT NULL,
+	kind ENUM('surcharge', 'override'),
+	weekdays TINYINT UNSIGNED NOT NULL,
+	start_time VARCHAR(5),
+	end_time VARCHAR(5),
+	valid_from DATETIME NULL,
+	valid_until DATETIME NULL,
+	percent INT NOT NULL,
+	price INT UNSIGNED NOT NULL,
+	priority INT NOT NULL,
+	PRIMARY KEY (id),
+	INDEX idx_pricing_rules_field_id (field_id),
+	CONSTRAINT fk_pricing_rules_field FOREIGN KEY (field_id) REFERENCES fields (id) ON UPDATE CASCADE ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
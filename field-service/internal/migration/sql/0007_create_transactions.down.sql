@@ -0,0 +1,2 @@
+DROP TABLE transaction_details;
+DROP TABLE transactions;
@@ -0,0 +1,25 @@
+-- user_id refers to users of the user service and is not enforced here.
+CREATE TABLE transactions (
+	transaction_id VARCHAR(35) NOT NULL,
+	user_id BIGINT UNSIGNED NOT NULL,
+	order_id VARCHAR(100),
+	payment_type VARCHAR(100),
+	payment_url VARCHAR(255),
+	payment_status ENUM('unpaid', 'rejected', 'success'),
+	total_price INT UNSIGNED NOT NULL,
+	transaction_time DATETIME NULL,
+	settlement_time DATETIME NULL,
+	fraud_status VARCHAR(100),
+	PRIMARY KEY (transaction_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE transaction_details (
+	id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	transaction_id VARCHAR(35) NOT NULL,
+	schedule_id BIGINT UNSIGNED NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	price INT UNSIGNED NOT NULL,
+	PRIMARY KEY (id),
+	CONSTRAINT fk_transactions_transaction_detail FOREIGN KEY (transaction_id) REFERENCES transactions (transaction_id) ON UPDATE CASCADE ON DELETE CASCADE,
+	CONSTRAINT fk_schedules_transaction_detail FOREIGN KEY (schedule_id) REFERENCES schedules (id) ON UPDATE CASCADE ON DELETE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,16 @@
+-- user_id refers to users of the user service and is not enforced here.
+CREATE TABLE reviews (
+	id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	field_id BIGINT UNSIGNED NOT NULL,
+	schedule_id BIGINT UNSIGNED NOT NULL,
+	user_id BIGINT UNSIGNED NOT NULL,
+	rating TINYINT UNSIGNED NOT NULL,
+	comment TEXT,
+	created_at DATETIME(3),
+	PRIMARY KEY (id),
+	UNIQUE INDEX idx_reviews_schedule_id (schedule_id),
+	INDEX idx_reviews_field_id (field_id),
+	INDEX idx_reviews_user_id (user_id),
+	CONSTRAINT fk_reviews_field FOREIGN KEY (field_id) REFERENCES fields (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+	CONSTRAINT fk_reviews_schedule FOREIGN KEY (schedule_id) REFERENCES schedules (id) ON UPDATE CASCADE ON DELETE RESTRICT
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
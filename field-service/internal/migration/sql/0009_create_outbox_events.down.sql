@@ -0,0 +1,3 @@
+-- The table is shared with the other services; only the events of this one
+-- are removed.
+DELETE FROM outbox_events WHERE source = 'field-service';
@@ -0,0 +1,16 @@
+-- The outbox is shared with the other services, each relaying the events of
+-- its own source, so whichever service migrates first creates it.
+CREATE TABLE IF NOT EXISTS outbox_events (
+	id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	source VARCHAR(50) NOT NULL,
+	type VARCHAR(100) NOT NULL,
+	aggregate_type VARCHAR(50) NOT NULL,
+	aggregate_id VARCHAR(50) NOT NULL,
+	payload TEXT NOT NULL,
+	created_at DATETIME(3),
+	published_at DATETIME(3) NULL,
+	attempts INT UNSIGNED NOT NULL DEFAULT 0,
+	last_error VARCHAR(255),
+	PRIMARY KEY (id),
+	INDEX idx_outbox_pending (source, published_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,3 @@
+-- Slots created by the template generator are flagged so it only ever removes
+-- its own slots. Slots generated before this migration count as made by hand.
+ALTER TABLE schedules ADD COLUMN generated BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,3 @@
+-- Expired transactions were never paid, they are kept as rejected.
+UPDATE transactions SET payment_status = 'rejected' WHERE payment_status = 'expired';
+ALTER TABLE transactions MODIFY payment_status ENUM('unpaid', 'rejected', 'success');
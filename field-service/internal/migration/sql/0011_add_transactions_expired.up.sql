@@ -0,0 +1,2 @@
+-- The hold sweeper expires the unpaid transactions of the slots it releases.
+ALTER TABLE transactions MODIFY payment_status ENUM('unpaid', 'rejected', 'success', 'expired');
@@ -0,0 +1,64 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	outboxkit "github.com/DevisArya/learn-microservices/svckit/outbox"
+)
+
+// Source marks the outbox events written by this service.
+const Source = "field-service"
+
+// Event types published by this service.
+const (
+	EventFieldCreated      = "field.created"
+	EventFieldPriceChanged = "field.price_changed"
+	EventScheduleReserved  = "schedule.reserved"
+)
+
+type FieldCreated struct {
+	FieldId  uint   `json:"field_id"`
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Price    uint32 `json:"price"`
+	VenueId  *uint  `json:"venue_id,omitempty"`
+	ParentId *uint  `json:"parent_id,omitempty"`
+}
+
+type FieldPriceChanged struct {
+	FieldId  uint   `json:"field_id"`
+	OldPrice uint32 `json:"old_price"`
+	NewPrice uint32 `json:"new_price"`
+}
+
+type ScheduleReserved struct {
+	ScheduleId    uint       `json:"schedule_id"`
+	FieldId       uint       `json:"field_id"`
+	UserId        uint       `json:"user_id"`
+	Date          time.Time  `json:"date"`
+	EndDate       time.Time  `json:"end_date"`
+	HoldExpiresAt *time.Time `json:"hold_expires_at,omitempty"`
+}
+
+// NewEvent returns an outbox event of eventType written by this service about
+// the aggregate, with payload encoded as JSON.
+func NewEvent(eventType string, aggregateType string, aggregateId uint, payload any) (*outboxkit.Event, error) {
+	return outboxkit.NewEvent(Source, eventType, aggregateType, aggregateId, payload)
+}
+
+// NewScheduleReserved returns the event of schedule being reserved.
+func NewScheduleReserved(schedule *entity.Schedule) (*outboxkit.Event, error) {
+	payload := ScheduleReserved{
+		ScheduleId:    schedule.Id,
+		FieldId:       schedule.FieldId,
+		Date:          schedule.Date,
+		EndDate:       schedule.EndDate,
+		HoldExpiresAt: schedule.HoldExpiresAt,
+	}
+	if schedule.UserId != nil {
+		payload.UserId = *schedule.UserId
+	}
+
+	return NewEvent(EventScheduleReserved, "schedule", schedule.Id, payload)
+}
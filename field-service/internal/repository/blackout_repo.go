@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"gorm.io/gorm"
+)
+
+type BlackoutRepository interface {
+	Save(ctx context.Context, blackout *entity.Blackout) (*entity.Blackout, error)
+	Delete(ctx context.Context, blackoutId uint) error
+	FindById(ctx context.Context, blackoutId uint) (*entity.Blackout, error)
+	FindByField(ctx context.Context, fieldId uint, start time.Time, end time.Time) (*[]entity.Blackout, error)
+}
+
+type BlackoutRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewBlackoutRepository(DB *gorm.DB) BlackoutRepository {
+	return &BlackoutRepositoryImpl{
+		DB: DB,
+	}
+}
+
+// Save implements BlackoutRepository
+func (repository *BlackoutRepositoryImpl) Save(ctx context.Context, blackout *entity.Blackout) (*entity.Blackout, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Omit("Field").Create(blackout).Error; err != nil {
+		return nil, err
+	}
+	return blackout, nil
+}
+
+// Delete implements BlackoutRepository
+func (repository *BlackoutRepositoryImpl) Delete(ctx context.Context, blackoutId uint) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Delete(&entity.Blackout{}, blackoutId).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindById implements BlackoutRepository
+func (repository *BlackoutRepositoryImpl) FindById(ctx context.Context, blackoutId uint) (*entity.Blackout, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var blackout entity.Blackout
+
+	if err := tx.First(&blackout, blackoutId).Error; err != nil {
+		return nil, err
+	}
+	return &blackout, nil
+}
+
+// FindByField implements BlackoutRepository. It returns every blackout of the
+// field overlapping [start, end).
+func (repository *BlackoutRepositoryImpl) FindByField(ctx context.Context, fieldId uint, start time.Time, end time.Time) (*[]entity.Blackout, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var blackouts []entity.Blackout
+
+	if err := tx.
+		Where("field_id = ? AND start_at < ? AND end_at > ?", fieldId, end, start).
+		Order("start_at ASC").
+		Find(&blackouts).Error; err != nil {
+		return nil, err
+	}
+
+	return &blackouts, nil
+}
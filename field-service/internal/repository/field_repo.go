@@ -2,48 +2,85 @@ package repository
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
 	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type FieldRepository interface {
-	Save(ctx context.Context, tx *gorm.DB, field *entity.Field) (*entity.Field, error)
-	Update(ctx context.Context, tx *gorm.DB, field *entity.Field) error
-	Delete(ctx context.Context, tx *gorm.DB, fieldId uint) error
-	FindById(ctx context.Context, tx *gorm.DB, fieldId uint) (*entity.Field, error)
-	FindAll(ctx context.Context, tx *gorm.DB, limit uint32, offset uint32) (*[]entity.Field, int64, error)
+	Save(ctx context.Context, field *entity.Field) (*entity.Field, error)
+	Update(ctx context.Context, field *entity.Field) error
+	Delete(ctx context.Context, fieldId uint) error
+	FindById(ctx context.Context, fieldId uint) (*entity.Field, error)
+	LockById(ctx context.Context, fieldId uint) (*entity.Field, error)
+	LockFamilies(ctx context.Context, fieldIds []uint) error
+	FindAll(ctx context.Context, filter *dto.FieldFilter, limit uint32, offset uint32) (*[]entity.Field, int64, error)
+	FindAllByCursor(ctx context.Context, filter *dto.FieldFilter, cursor *dto.Cursor, limit uint32) (*[]entity.Field, bool, error)
+	Count(ctx context.Context, filter *dto.FieldFilter) (int64, error)
+	FindAvailable(ctx context.Context, filter *dto.AvailabilityFilter) (*[]entity.Field, error)
+	FindDeletedById(ctx context.Context, fieldId uint) (*entity.Field, error)
+	FindAllDeleted(ctx context.Context, limit uint32, offset uint32) (*[]entity.Field, int64, error)
+	Restore(ctx context.Context, fieldId uint) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	RefreshRating(ctx context.Context, fieldId uint) error
+	CountParts(ctx context.Context, fieldId uint) (int64, error)
 }
 
-type FieldRepositoryImpl struct{}
+type FieldRepositoryImpl struct {
+	DB *gorm.DB
+}
 
-func NewFieldRepository() FieldRepository {
-	return &FieldRepositoryImpl{}
+func NewFieldRepository(DB *gorm.DB) FieldRepository {
+	return &FieldRepositoryImpl{
+		DB: DB,
+	}
 }
 
 // Save implements FieldRepository
-func (repository *FieldRepositoryImpl) Save(ctx context.Context, tx *gorm.DB, field *entity.Field) (*entity.Field, error) {
+func (repository *FieldRepositoryImpl) Save(ctx context.Context, field *entity.Field) (*entity.Field, error) {
+	tx := txmanager.DB(ctx, repository.DB)
 
-	if err := tx.WithContext(ctx).Create(field).Error; err != nil {
+	if err := tx.Create(field).Error; err != nil {
 		return nil, err
 	}
 	return field, nil
 }
 
 // Update implements FieldRepository
-func (repository *FieldRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, field *entity.Field) error {
+func (repository *FieldRepositoryImpl) Update(ctx context.Context, field *entity.Field) error {
+	tx := txmanager.DB(ctx, repository.DB)
 
-	if err := tx.WithContext(ctx).Updates(&field).Error; err != nil {
+	if err := tx.Updates(&field).Error; err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// Delete implements FieldRepository
-func (repository *FieldRepositoryImpl) Delete(ctx context.Context, tx *gorm.DB, fieldId uint) error {
+// Delete implements FieldRepository. The field is soft deleted and its future
+// slots nobody booked, generated or made by hand, are removed so they can not
+// be booked any more; booked slots and those a transaction or review refers to
+// are kept as history.
+func (repository *FieldRepositoryImpl) Delete(ctx context.Context, fieldId uint) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	details := tx.Model(&entity.TransactionDetail{}).Select("schedule_id")
+	reviews := tx.Model(&entity.Review{}).Select("schedule_id")
+
+	if err := tx.
+		Where("field_id = ? AND status = ? AND date > ?", fieldId, entity.ScheduleStatusAvailable, time.Now()).
+		Where("id NOT IN (?) AND id NOT IN (?)", details, reviews).
+		Delete(&entity.Schedule{}).Error; err != nil {
+		return err
+	}
 
-	if err := tx.WithContext(ctx).Delete(&entity.Field{}, fieldId).Error; err != nil {
+	if err := tx.Delete(&entity.Field{}, fieldId).Error; err != nil {
 		return err
 	}
 
@@ -51,27 +88,380 @@ func (repository *FieldRepositoryImpl) Delete(ctx context.Context, tx *gorm.DB,
 }
 
 // FindById implements FieldRepository
-func (repository *FieldRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, fieldId uint) (*entity.Field, error) {
+func (repository *FieldRepositoryImpl) FindById(ctx context.Context, fieldId uint) (*entity.Field, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var field entity.Field
+
+	if err := tx.First(&field, fieldId).Error; err != nil {
+		return nil, err
+	}
+	return &field, nil
+}
+
+// LockFamilies implements FieldRepository. It locks, until tx ends, the row
+// heading the divisible field family of each of fieldIds: the parent of a part,
+// the field itself otherwise. Rows are locked in id order, so bookings in the
+// same families are serialized and always wait for each other in the same
+// order instead of deadlocking.
+func (repository *FieldRepositoryImpl) LockFamilies(ctx context.Context, fieldIds []uint) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if len(fieldIds) == 0 {
+		return nil
+	}
+
+	heads := tx.Unscoped().Model(&entity.Field{}).Select("COALESCE(parent_id, id)").Where("id IN ?", fieldIds)
+
+	var lockedIds []uint
+
+	if err := tx.Unscoped().Model(&entity.Field{}).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN (?)", heads).
+		Order("id ASC").
+		Pluck("id", &lockedIds).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// LockById implements FieldRepository. It locks the field row until tx ends,
+// serializing writers that check then insert schedules of the field.
+func (repository *FieldRepositoryImpl) LockById(ctx context.Context, fieldId uint) (*entity.Field, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
 	var field entity.Field
 
-	if err := tx.WithContext(ctx).First(&field, fieldId).Error; err != nil {
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&field, fieldId).Error; err != nil {
 		return nil, err
 	}
 	return &field, nil
 }
 
 // FindAll implements FieldRepository
-func (repository *FieldRepositoryImpl) FindAll(ctx context.Context, tx *gorm.DB, limit uint32, offset uint32) (*[]entity.Field, int64, error) {
+func (repository *FieldRepositoryImpl) FindAll(ctx context.Context, filter *dto.FieldFilter, limit uint32, offset uint32) (*[]entity.Field, int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
 	var fields []entity.Field
 	var count int64
 
-	if err := tx.WithContext(ctx).Model(&entity.Field{}).Count(&count).Error; err != nil {
+	if err := tx.Model(&entity.Field{}).Scopes(fieldFilter(filter)).Count(&count).Error; err != nil {
 		return nil, 0, err
 	}
 
-	if err := tx.WithContext(ctx).Limit(int(limit)).Offset(int(offset)).Order("id ASC").Find(&fields).Error; err != nil {
+	if err := tx.Scopes(fieldFilter(filter)).Limit(int(limit)).Offset(int(offset)).Order(fieldOrder(filter)).Find(&fields).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return &fields, count, nil
 }
+
+// FindAllByCursor implements FieldRepository. It returns at most limit fields
+// after (or before, for a Prev cursor) the cursor and whether more rows exist
+// beyond the returned page in the direction of travel.
+func (repository *FieldRepositoryImpl) FindAllByCursor(ctx context.Context, filter *dto.FieldFilter, cursor *dto.Cursor, limit uint32) (*[]entity.Field, bool, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var fields []entity.Field
+
+	column, desc := fieldSortKey(filter)
+	backward := cursor != nil && cursor.Prev
+
+	//walking backwards flips the order, the page is reversed after the query
+	direction, operator := "ASC", ">"
+	if desc != backward {
+		direction, operator = "DESC", "<"
+	}
+
+	query := tx.Scopes(fieldFilter(filter))
+
+	if cursor != nil {
+		if column == "id" {
+			query = query.Where("id "+operator+" ?", cursor.Id)
+		} else {
+			value, err := fieldCursorValue(column, cursor.Value)
+			if err != nil {
+				return nil, false, err
+			}
+			query = query.Where("("+column+" "+operator+" ?) OR ("+column+" = ? AND id "+operator+" ?)", value, value, cursor.Id)
+		}
+	}
+
+	order := "id " + direction
+	if column != "id" {
+		order = column + " " + direction + ", " + order
+	}
+
+	if err := query.Order(order).Limit(int(limit) + 1).Find(&fields).Error; err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(fields) > int(limit)
+	if hasMore {
+		fields = fields[:limit]
+	}
+
+	if backward {
+		for i, j := 0, len(fields)-1; i < j; i, j = i+1, j-1 {
+			fields[i], fields[j] = fields[j], fields[i]
+		}
+	}
+
+	return &fields, hasMore, nil
+}
+
+// Count implements FieldRepository
+func (repository *FieldRepositoryImpl) Count(ctx context.Context, filter *dto.FieldFilter) (int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var count int64
+
+	if err := tx.Model(&entity.Field{}).Scopes(fieldFilter(filter)).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// notBlackedOut keeps schedules that do not overlap a blackout window of their
+// field.
+const notBlackedOut = "NOT EXISTS (SELECT 1 FROM blackouts WHERE blackouts.field_id = schedules.field_id AND schedules.date < blackouts.end_at AND schedules.end_date > blackouts.start_at)"
+
+// notBlockedByRelated keeps schedules whose field has no parent or part booked
+// at an overlapping time.
+const notBlockedByRelated = "NOT EXISTS (SELECT 1 FROM schedules related JOIN fields related_field ON related_field.id = related.field_id JOIN fields own_field ON own_field.id = schedules.field_id WHERE related.date < schedules.end_date AND related.end_date > schedules.date AND related.status IN ('reserved', 'sold') AND related_field.deleted_at IS NULL AND (related_field.id = own_field.parent_id OR related_field.parent_id = own_field.id))"
+
+// FindAvailable implements FieldRepository
+func (repository *FieldRepositoryImpl) FindAvailable(ctx context.Context, filter *dto.AvailabilityFilter) (*[]entity.Field, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var fields []entity.Field
+
+	minSlots := filter.MinSlots
+	if minSlots < 1 {
+		minSlots = 1
+	}
+
+	availableSlots := tx.Model(&entity.Schedule{}).
+		Select("field_id").
+		Where("status = ? AND date >= ? AND date < ?", entity.ScheduleStatusAvailable, filter.StartDate, filter.EndDate).
+		Where(notBlackedOut).
+		Where(notBlockedByRelated).
+		Group("field_id").
+		Having("COUNT(*) >= ?", minSlots)
+
+	query := tx.Where("id IN (?)", availableSlots)
+
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+	if filter.MinPrice != nil {
+		query = query.Where("price >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		query = query.Where("price <= ?", *filter.MaxPrice)
+	}
+
+	if err := query.
+		Preload("Schedule", func(db *gorm.DB) *gorm.DB {
+			return db.Where("status = ? AND date >= ? AND date < ?", entity.ScheduleStatusAvailable, filter.StartDate, filter.EndDate).
+				Where(notBlackedOut).
+				Where(notBlockedByRelated).
+				Order("date ASC")
+		}).
+		Order("price ASC, id ASC").
+		Find(&fields).Error; err != nil {
+		return nil, err
+	}
+
+	return &fields, nil
+}
+
+// FindDeletedById implements FieldRepository
+func (repository *FieldRepositoryImpl) FindDeletedById(ctx context.Context, fieldId uint) (*entity.Field, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var field entity.Field
+
+	if err := tx.Unscoped().Where("deleted_at IS NOT NULL").First(&field, fieldId).Error; err != nil {
+		return nil, err
+	}
+	return &field, nil
+}
+
+// FindAllDeleted implements FieldRepository
+func (repository *FieldRepositoryImpl) FindAllDeleted(ctx context.Context, limit uint32, offset uint32) (*[]entity.Field, int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var fields []entity.Field
+	var count int64
+
+	if err := tx.Unscoped().Model(&entity.Field{}).Where("deleted_at IS NOT NULL").Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Unscoped().Where("deleted_at IS NOT NULL").Limit(int(limit)).Offset(int(offset)).Order("deleted_at DESC, id DESC").Find(&fields).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return &fields, count, nil
+}
+
+// Restore implements FieldRepository
+func (repository *FieldRepositoryImpl) Restore(ctx context.Context, fieldId uint) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Unscoped().Model(&entity.Field{}).Where("id = ?", fieldId).Update("deleted_at", nil).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Purge implements FieldRepository. Fields with booked schedules, reviews or
+// parts are kept so the history referring to them survives; a parent goes once
+// its parts are gone. The schedules of a purged field are all available and
+// unreferenced, they are removed with it.
+func (repository *FieldRepositoryImpl) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	details := tx.Model(&entity.TransactionDetail{}).Select("schedule_id")
+	reviewedSchedules := tx.Model(&entity.Review{}).Select("schedule_id")
+	booked := tx.Model(&entity.Schedule{}).
+		Select("field_id").
+		Where("status <> ? OR id IN (?) OR id IN (?)", entity.ScheduleStatusAvailable, details, reviewedSchedules)
+	reviewed := tx.Model(&entity.Review{}).Select("field_id")
+	parents := tx.Unscoped().Model(&entity.Field{}).Select("parent_id").Where("parent_id IS NOT NULL")
+
+	var fieldIds []uint
+
+	if err := tx.Unscoped().Model(&entity.Field{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+		Where("id NOT IN (?) AND id NOT IN (?) AND id NOT IN (?)", booked, reviewed, parents).
+		Pluck("id", &fieldIds).Error; err != nil {
+		return 0, err
+	}
+
+	if len(fieldIds) == 0 {
+		return 0, nil
+	}
+
+	if err := tx.Where("field_id IN ?", fieldIds).Delete(&entity.Schedule{}).Error; err != nil {
+		return 0, err
+	}
+
+	result := tx.Unscoped().Where("id IN ?", fieldIds).Delete(&entity.Field{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// RefreshRating implements FieldRepository. The summary is recomputed from the
+// reviews rather than adjusted, so it can not drift.
+func (repository *FieldRepositoryImpl) RefreshRating(ctx context.Context, fieldId uint) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	reviews := tx.Model(&entity.Review{}).Where("field_id = ?", fieldId)
+
+	if err := tx.Model(&entity.Field{}).Where("id = ?", fieldId).Updates(map[string]interface{}{
+		"rating_average": reviews.Session(&gorm.Session{}).Select("COALESCE(AVG(rating), 0)"),
+		"rating_count":   reviews.Session(&gorm.Session{}).Select("COUNT(*)"),
+	}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CountParts implements FieldRepository
+func (repository *FieldRepositoryImpl) CountParts(ctx context.Context, fieldId uint) (int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var count int64
+
+	if err := tx.Model(&entity.Field{}).Where("parent_id = ?", fieldId).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// fieldSortColumns whitelists the columns GetFields may be sorted by.
+var fieldSortColumns = map[string]string{
+	"price":      "price",
+	"name":       "name",
+	"created_at": "created_at",
+	"rating":     "rating_average",
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func fieldFilter(filter *dto.FieldFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if filter == nil {
+			return db
+		}
+		if filter.Type != "" {
+			db = db.Where("type = ?", filter.Type)
+		}
+		if filter.MinPrice != nil {
+			db = db.Where("price >= ?", *filter.MinPrice)
+		}
+		if filter.MaxPrice != nil {
+			db = db.Where("price <= ?", *filter.MaxPrice)
+		}
+		if filter.Search != "" {
+			pattern := "%" + likeEscaper.Replace(filter.Search) + "%"
+			db = db.Where("name LIKE ? OR description LIKE ?", pattern, pattern)
+		}
+		return db
+	}
+}
+
+// fieldSortKey returns the column fields are ordered by and whether the order
+// is descending. Fields without a sort spec are ordered by id ascending.
+func fieldSortKey(filter *dto.FieldFilter) (string, bool) {
+	if filter == nil {
+		return "id", false
+	}
+
+	column, ok := fieldSortColumns[filter.SortBy]
+	if !ok {
+		return "id", false
+	}
+
+	return column, filter.SortOrder == "desc"
+}
+
+func fieldOrder(filter *dto.FieldFilter) string {
+	column, desc := fieldSortKey(filter)
+
+	direction := "ASC"
+	if desc {
+		direction = "DESC"
+	}
+
+	if column == "id" {
+		return "id " + direction
+	}
+
+	return column + " " + direction + ", id " + direction
+}
+
+// fieldCursorValue converts the sort value stored in a cursor back to the type
+// of its column.
+func fieldCursorValue(column string, value string) (interface{}, error) {
+	switch column {
+	case "price":
+		return strconv.ParseUint(value, 10, 32)
+	case "created_at":
+		return time.Parse(time.RFC3339Nano, value)
+	case "rating_average":
+		return strconv.ParseFloat(value, 64)
+	default:
+		return value, nil
+	}
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"gorm.io/gorm"
+)
+
+type PricingRuleRepository interface {
+	Save(ctx context.Context, rule *entity.PricingRule) (*entity.PricingRule, error)
+	Delete(ctx context.Context, ruleId uint) error
+	FindById(ctx context.Context, ruleId uint) (*entity.PricingRule, error)
+	FindByField(ctx context.Context, fieldId uint) (*[]entity.PricingRule, error)
+}
+
+type PricingRuleRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewPricingRuleRepository(DB *gorm.DB) PricingRuleRepository {
+	return &PricingRuleRepositoryImpl{
+		DB: DB,
+	}
+}
+
+// Save implements PricingRuleRepository
+func (repository *PricingRuleRepositoryImpl) Save(ctx context.Context, rule *entity.PricingRule) (*entity.PricingRule, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Omit("Field").Create(rule).Error; err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// Delete implements PricingRuleRepository
+func (repository *PricingRuleRepositoryImpl) Delete(ctx context.Context, ruleId uint) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Delete(&entity.PricingRule{}, ruleId).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindById implements PricingRuleRepository
+func (repository *PricingRuleRepositoryImpl) FindById(ctx context.Context, ruleId uint) (*entity.PricingRule, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var rule entity.PricingRule
+
+	if err := tx.First(&rule, ruleId).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// FindByField implements PricingRuleRepository
+func (repository *PricingRuleRepositoryImpl) FindByField(ctx context.Context, fieldId uint) (*[]entity.PricingRule, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var rules []entity.PricingRule
+
+	if err := tx.Where("field_id = ?", fieldId).Order("priority DESC, id ASC").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return &rules, nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"gorm.io/gorm"
+)
+
+type ReviewRepository interface {
+	Save(ctx context.Context, review *entity.Review) (*entity.Review, error)
+	Delete(ctx context.Context, reviewId uint) error
+	FindById(ctx context.Context, reviewId uint) (*entity.Review, error)
+	FindBySchedule(ctx context.Context, scheduleId uint) (*entity.Review, error)
+	FindByField(ctx context.Context, fieldId uint, limit uint32, offset uint32) (*[]entity.Review, int64, error)
+}
+
+type ReviewRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewReviewRepository(DB *gorm.DB) ReviewRepository {
+	return &ReviewRepositoryImpl{
+		DB: DB,
+	}
+}
+
+// Save implements ReviewRepository
+func (repository *ReviewRepositoryImpl) Save(ctx context.Context, review *entity.Review) (*entity.Review, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Omit("Field", "Schedule", "User").Create(review).Error; err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+// Delete implements ReviewRepository
+func (repository *ReviewRepositoryImpl) Delete(ctx context.Context, reviewId uint) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Delete(&entity.Review{}, reviewId).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindById implements ReviewRepository
+func (repository *ReviewRepositoryImpl) FindById(ctx context.Context, reviewId uint) (*entity.Review, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var review entity.Review
+
+	if err := tx.First(&review, reviewId).Error; err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// FindBySchedule implements ReviewRepository
+func (repository *ReviewRepositoryImpl) FindBySchedule(ctx context.Context, scheduleId uint) (*entity.Review, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var review entity.Review
+
+	if err := tx.Where("schedule_id = ?", scheduleId).First(&review).Error; err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+// FindByField implements ReviewRepository
+func (repository *ReviewRepositoryImpl) FindByField(ctx context.Context, fieldId uint, limit uint32, offset uint32) (*[]entity.Review, int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var reviews []entity.Review
+	var count int64
+
+	if err := tx.Model(&entity.Review{}).Where("field_id = ?", fieldId).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Where("field_id = ?", fieldId).Limit(int(limit)).Offset(int(offset)).Order("created_at DESC, id DESC").Find(&reviews).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return &reviews, count, nil
+}
@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ScheduleRepository interface {
+	Save(ctx context.Context, schedule *entity.Schedule) (*entity.Schedule, error)
+	UpdateStatus(ctx context.Context, scheduleId uint, version uint, status entity.ScheduleStatus, userId *uint, holdExpiresAt *time.Time) (int64, error)
+	ExtendHold(ctx context.Context, scheduleId uint, userId uint, now time.Time, holdExpiresAt time.Time) (int64, error)
+	FindExpiredHolds(ctx context.Context, now time.Time) (*[]entity.Schedule, error)
+	Delete(ctx context.Context, scheduleId uint) error
+	FindById(ctx context.Context, scheduleId uint) (*entity.Schedule, error)
+	FindByFieldAndDate(ctx context.Context, fieldId uint, date time.Time) (*entity.Schedule, error)
+	FindByField(ctx context.Context, fieldId uint, start time.Time, end time.Time) (*[]entity.Schedule, error)
+	FindByIds(ctx context.Context, scheduleIds []uint) (*[]entity.Schedule, error)
+	FindGeneratedFieldIds(ctx context.Context, after time.Time) ([]uint, error)
+	FindReferencedIds(ctx context.Context, scheduleIds []uint) ([]uint, error)
+	FindRelatedBookings(ctx context.Context, fieldId uint, start time.Time, end time.Time) (*[]entity.Schedule, error)
+	LockRelated(ctx context.Context, fieldId uint, start time.Time, end time.Time) (*[]entity.Schedule, error)
+}
+
+type ScheduleRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewScheduleRepository(DB *gorm.DB) ScheduleRepository {
+	return &ScheduleRepositoryImpl{
+		DB: DB,
+	}
+}
+
+// Save implements ScheduleRepository
+func (repository *ScheduleRepositoryImpl) Save(ctx context.Context, schedule *entity.Schedule) (*entity.Schedule, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Omit("User", "Field", "TransactionDetail").Create(schedule).Error; err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// UpdateStatus implements ScheduleRepository. The update is a compare-and-swap
+// on version: it only applies while the row still has the version the caller
+// read, and zero updated rows means another writer got there first.
+func (repository *ScheduleRepositoryImpl) UpdateStatus(ctx context.Context, scheduleId uint, version uint, status entity.ScheduleStatus, userId *uint, holdExpiresAt *time.Time) (int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	result := tx.Model(&entity.Schedule{}).
+		Where("id = ? AND version = ?", scheduleId, version).
+		Updates(map[string]interface{}{
+			"status":          status,
+			"user_id":         userId,
+			"hold_expires_at": holdExpiresAt,
+			"version":         gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// ExtendHold implements ScheduleRepository. The hold is only moved while it is
+// still active and held by userId, so it can not race with the sweeper
+// releasing it; the number of updated rows tells whether it was extended.
+func (repository *ScheduleRepositoryImpl) ExtendHold(ctx context.Context, scheduleId uint, userId uint, now time.Time, holdExpiresAt time.Time) (int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	result := tx.Model(&entity.Schedule{}).
+		Where("id = ? AND status = ? AND user_id = ? AND hold_expires_at > ?", scheduleId, entity.ScheduleStatusReserved, userId, now).
+		Updates(map[string]interface{}{
+			"hold_expires_at": holdExpiresAt,
+			"version":         gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
+// FindExpiredHolds implements ScheduleRepository
+func (repository *ScheduleRepositoryImpl) FindExpiredHolds(ctx context.Context, now time.Time) (*[]entity.Schedule, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var schedules []entity.Schedule
+
+	if err := tx.
+		Where("status = ? AND hold_expires_at <= ?", entity.ScheduleStatusReserved, now).
+		Order("hold_expires_at ASC").
+		Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+
+	return &schedules, nil
+}
+
+// Delete implements ScheduleRepository
+func (repository *ScheduleRepositoryImpl) Delete(ctx context.Context, scheduleId uint) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Delete(&entity.Schedule{}, scheduleId).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindById implements ScheduleRepository
+func (repository *ScheduleRepositoryImpl) FindById(ctx context.Context, scheduleId uint) (*entity.Schedule, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var schedule entity.Schedule
+
+	if err := tx.First(&schedule, scheduleId).Error; err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// FindByFieldAndDate implements ScheduleRepository
+func (repository *ScheduleRepositoryImpl) FindByFieldAndDate(ctx context.Context, fieldId uint, date time.Time) (*entity.Schedule, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var schedule entity.Schedule
+
+	if err := tx.Where("field_id = ? AND date = ?", fieldId, date).First(&schedule).Error; err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// FindByField implements ScheduleRepository. It returns the schedules
+// overlapping [start, end).
+func (repository *ScheduleRepositoryImpl) FindByField(ctx context.Context, fieldId uint, start time.Time, end time.Time) (*[]entity.Schedule, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var schedules []entity.Schedule
+
+	if err := tx.
+		Where("field_id = ? AND date < ? AND end_date > ?", fieldId, end, start).
+		Order("date ASC").
+		Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+
+	return &schedules, nil
+}
+
+// FindGeneratedFieldIds implements ScheduleRepository. It returns the fields
+// that have generated slots still available after the given time. Soft deleted
+// fields are left out, the generator does not touch them.
+func (repository *ScheduleRepositoryImpl) FindGeneratedFieldIds(ctx context.Context, after time.Time) ([]uint, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var fieldIds []uint
+
+	if err := tx.Model(&entity.Schedule{}).
+		Joins("JOIN fields ON fields.id = schedules.field_id AND fields.deleted_at IS NULL").
+		Where("schedules.generated = ? AND schedules.status = ? AND schedules.date > ?", true, entity.ScheduleStatusAvailable, after).
+		Distinct().
+		Pluck("schedules.field_id", &fieldIds).Error; err != nil {
+		return nil, err
+	}
+
+	return fieldIds, nil
+}
+
+// FindReferencedIds implements ScheduleRepository. It returns those of
+// scheduleIds a transaction or a review refers to; their rows are history and
+// can not be deleted.
+func (repository *ScheduleRepositoryImpl) FindReferencedIds(ctx context.Context, scheduleIds []uint) ([]uint, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if len(scheduleIds) == 0 {
+		return nil, nil
+	}
+
+	details := tx.Model(&entity.TransactionDetail{}).Select("schedule_id")
+	reviews := tx.Model(&entity.Review{}).Select("schedule_id")
+
+	var referencedIds []uint
+
+	if err := tx.Model(&entity.Schedule{}).
+		Where("id IN ?", scheduleIds).
+		Where("id IN (?) OR id IN (?)", details, reviews).
+		Pluck("id", &referencedIds).Error; err != nil {
+		return nil, err
+	}
+
+	return referencedIds, nil
+}
+
+// FindByIds implements ScheduleRepository
+func (repository *ScheduleRepositoryImpl) FindByIds(ctx context.Context, scheduleIds []uint) (*[]entity.Schedule, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var schedules []entity.Schedule
+
+	if err := tx.Where("id IN ?", scheduleIds).Order("date ASC, id ASC").Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+
+	return &schedules, nil
+}
+
+// FindRelatedBookings implements ScheduleRepository. It returns the reserved
+// and sold schedules overlapping [start, end) of the parent and the parts of a
+// field.
+func (repository *ScheduleRepositoryImpl) FindRelatedBookings(ctx context.Context, fieldId uint, start time.Time, end time.Time) (*[]entity.Schedule, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var schedules []entity.Schedule
+
+	if err := tx.
+		Where("field_id IN (?) AND field_id <> ?", relatedFieldIds(tx, fieldId), fieldId).
+		Where("status IN ? AND date < ? AND end_date > ?", []entity.ScheduleStatus{entity.ScheduleStatusReserved, entity.ScheduleStatusSold}, end, start).
+		Order("date ASC").
+		Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+
+	return &schedules, nil
+}
+
+// LockRelated implements ScheduleRepository. It locks the schedules
+// overlapping [start, end) of a field, its parent and its parts, so bookings
+// of overlapping parts of a divisible field are serialized.
+func (repository *ScheduleRepositoryImpl) LockRelated(ctx context.Context, fieldId uint, start time.Time, end time.Time) (*[]entity.Schedule, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var schedules []entity.Schedule
+
+	if err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("field_id IN (?) AND date < ? AND end_date > ?", relatedFieldIds(tx, fieldId), end, start).
+		Order("id ASC").
+		Find(&schedules).Error; err != nil {
+		return nil, err
+	}
+
+	return &schedules, nil
+}
+
+// relatedFieldIds selects the id of a field, of its parent and of its parts.
+func relatedFieldIds(tx *gorm.DB, fieldId uint) *gorm.DB {
+	parent := tx.Model(&entity.Field{}).Select("parent_id").Where("id = ?", fieldId)
+
+	return tx.Model(&entity.Field{}).Select("id").Where("id = ? OR parent_id = ? OR id = (?)", fieldId, fieldId, parent)
+}
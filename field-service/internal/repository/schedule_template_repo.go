@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"gorm.io/gorm"
+)
+
+type ScheduleTemplateRepository interface {
+	Save(ctx context.Context, template *entity.ScheduleTemplate) (*entity.ScheduleTemplate, error)
+	Delete(ctx context.Context, templateId uint) error
+	FindById(ctx context.Context, templateId uint) (*entity.ScheduleTemplate, error)
+	FindByField(ctx context.Context, fieldId uint) (*[]entity.ScheduleTemplate, error)
+	FindAll(ctx context.Context) (*[]entity.ScheduleTemplate, error)
+}
+
+type ScheduleTemplateRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewScheduleTemplateRepository(DB *gorm.DB) ScheduleTemplateRepository {
+	return &ScheduleTemplateRepositoryImpl{
+		DB: DB,
+	}
+}
+
+// Save implements ScheduleTemplateRepository
+func (repository *ScheduleTemplateRepositoryImpl) Save(ctx context.Context, template *entity.ScheduleTemplate) (*entity.ScheduleTemplate, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Omit("Field").Create(template).Error; err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// Delete implements ScheduleTemplateRepository
+func (repository *ScheduleTemplateRepositoryImpl) Delete(ctx context.Context, templateId uint) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Delete(&entity.ScheduleTemplate{}, templateId).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindById implements ScheduleTemplateRepository
+func (repository *ScheduleTemplateRepositoryImpl) FindById(ctx context.Context, templateId uint) (*entity.ScheduleTemplate, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var template entity.ScheduleTemplate
+
+	if err := tx.First(&template, templateId).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// FindByField implements ScheduleTemplateRepository
+func (repository *ScheduleTemplateRepositoryImpl) FindByField(ctx context.Context, fieldId uint) (*[]entity.ScheduleTemplate, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var templates []entity.ScheduleTemplate
+
+	if err := tx.Where("field_id = ?", fieldId).Order("weekday ASC, open_time ASC").Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return &templates, nil
+}
+
+// FindAll implements ScheduleTemplateRepository
+func (repository *ScheduleTemplateRepositoryImpl) FindAll(ctx context.Context) (*[]entity.ScheduleTemplate, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var templates []entity.ScheduleTemplate
+
+	//templates of soft deleted fields are kept for restore but not generated
+	if err := tx.
+		Joins("JOIN fields ON fields.id = schedule_templates.field_id AND fields.deleted_at IS NULL").
+		Order("schedule_templates.field_id ASC, schedule_templates.weekday ASC, schedule_templates.open_time ASC").
+		Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return &templates, nil
+}
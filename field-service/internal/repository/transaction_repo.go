@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"gorm.io/gorm"
+)
+
+type TransactionRepository interface {
+	Save(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error)
+	FindById(ctx context.Context, transactionId string) (*entity.Transaction, error)
+	FindAll(ctx context.Context, filter *dto.TransactionFilter, limit uint32, offset uint32) (*[]entity.Transaction, int64, error)
+	ExpireUnpaidBySchedule(ctx context.Context, scheduleId uint) (int64, error)
+}
+
+type TransactionRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewTransactionRepository(DB *gorm.DB) TransactionRepository {
+	return &TransactionRepositoryImpl{
+		DB: DB,
+	}
+}
+
+// Save implements TransactionRepository. The details are created along with
+// the transaction.
+func (repository *TransactionRepositoryImpl) Save(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Omit("User", "SettlementTime").Create(transaction).Error; err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// FindById implements TransactionRepository
+func (repository *TransactionRepositoryImpl) FindById(ctx context.Context, transactionId string) (*entity.Transaction, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var transaction entity.Transaction
+
+	if err := tx.
+		Preload("TransactionDetail", func(db *gorm.DB) *gorm.DB { return db.Order("id ASC") }).
+		Where("transaction_id = ?", transactionId).
+		First(&transaction).Error; err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
+
+// FindAll implements TransactionRepository
+func (repository *TransactionRepositoryImpl) FindAll(ctx context.Context, filter *dto.TransactionFilter, limit uint32, offset uint32) (*[]entity.Transaction, int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var transactions []entity.Transaction
+	var count int64
+
+	query := tx.Model(&entity.Transaction{})
+	if filter.UserId != nil {
+		query = query.Where("user_id = ?", *filter.UserId)
+	}
+
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := query.
+		Preload("TransactionDetail", func(db *gorm.DB) *gorm.DB { return db.Order("id ASC") }).
+		Limit(int(limit)).Offset(int(offset)).
+		Order("transaction_time DESC, transaction_id DESC").
+		Find(&transactions).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return &transactions, count, nil
+}
+
+// ExpireUnpaidBySchedule implements TransactionRepository. It marks the
+// unpaid transactions booking scheduleId expired and returns how many there
+// were.
+func (repository *TransactionRepositoryImpl) ExpireUnpaidBySchedule(ctx context.Context, scheduleId uint) (int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	details := tx.Model(&entity.TransactionDetail{}).Select("transaction_id").Where("schedule_id = ?", scheduleId)
+
+	result := tx.Model(&entity.Transaction{}).
+		Where("payment_status = ? AND transaction_id IN (?)", entity.PaymentStatusUnpaid, details).
+		Update("payment_status", entity.PaymentStatusExpired)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"gorm.io/gorm"
+)
+
+type VenueRepository interface {
+	Save(ctx context.Context, venue *entity.Venue) (*entity.Venue, error)
+	Update(ctx context.Context, venue *entity.Venue) error
+	Delete(ctx context.Context, venueId uint) error
+	FindById(ctx context.Context, venueId uint) (*entity.Venue, error)
+	FindAll(ctx context.Context, limit uint32, offset uint32) (*[]entity.Venue, int64, error)
+	FindWithin(ctx context.Context, minLat, maxLat, minLng, maxLng float64) (*[]entity.Venue, error)
+	CountFields(ctx context.Context, venueId uint) (int64, error)
+}
+
+type VenueRepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewVenueRepository(DB *gorm.DB) VenueRepository {
+	return &VenueRepositoryImpl{
+		DB: DB,
+	}
+}
+
+// Save implements VenueRepository
+func (repository *VenueRepositoryImpl) Save(ctx context.Context, venue *entity.Venue) (*entity.Venue, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Omit("Fields").Create(venue).Error; err != nil {
+		return nil, err
+	}
+	return venue, nil
+}
+
+// Update implements VenueRepository
+func (repository *VenueRepositoryImpl) Update(ctx context.Context, venue *entity.Venue) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Model(&entity.Venue{Id: venue.Id}).Select("*").Omit("Id", "Fields").Updates(venue).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Delete implements VenueRepository
+func (repository *VenueRepositoryImpl) Delete(ctx context.Context, venueId uint) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Delete(&entity.Venue{}, venueId).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindById implements VenueRepository
+func (repository *VenueRepositoryImpl) FindById(ctx context.Context, venueId uint) (*entity.Venue, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var venue entity.Venue
+
+	if err := tx.Preload("Fields").First(&venue, venueId).Error; err != nil {
+		return nil, err
+	}
+	return &venue, nil
+}
+
+// FindAll implements VenueRepository
+func (repository *VenueRepositoryImpl) FindAll(ctx context.Context, limit uint32, offset uint32) (*[]entity.Venue, int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var venues []entity.Venue
+	var count int64
+
+	if err := tx.Model(&entity.Venue{}).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := tx.Preload("Fields").Limit(int(limit)).Offset(int(offset)).Order("id ASC").Find(&venues).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return &venues, count, nil
+}
+
+// FindWithin implements VenueRepository
+func (repository *VenueRepositoryImpl) FindWithin(ctx context.Context, minLat, maxLat, minLng, maxLng float64) (*[]entity.Venue, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var venues []entity.Venue
+
+	if err := tx.
+		Preload("Fields").
+		Where("latitude BETWEEN ? AND ? AND longitude BETWEEN ? AND ?", minLat, maxLat, minLng, maxLng).
+		Find(&venues).Error; err != nil {
+		return nil, err
+	}
+
+	return &venues, nil
+}
+
+// CountFields implements VenueRepository. Soft deleted fields are counted
+// too: they keep their venue until the purger removes them.
+func (repository *VenueRepositoryImpl) CountFields(ctx context.Context, venueId uint) (int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var count int64
+
+	if err := tx.Unscoped().Model(&entity.Field{}).Where("venue_id = ?", venueId).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/helper"
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+)
+
+type BlackoutUseCase interface {
+	Save(ctx context.Context, caller *dto.Caller, request *dto.BlackoutRequest) (*entity.Blackout, *[]entity.Schedule, error)
+	Delete(ctx context.Context, caller *dto.Caller, blackoutId uint) error
+	FindByField(ctx context.Context, filter *dto.ScheduleFilter) (*[]entity.Blackout, error)
+}
+
+type BlackoutUseCaseImpl struct {
+	BlackoutRepository repository.BlackoutRepository
+	ScheduleRepository repository.ScheduleRepository
+	FieldRepository    repository.FieldRepository
+	TxManager          txmanager.Manager
+	validate           *validator.Validate
+}
+
+func NewBlackoutUseCase(blackoutRepository repository.BlackoutRepository, scheduleRepository repository.ScheduleRepository, fieldRepository repository.FieldRepository, txManager txmanager.Manager, validate *validator.Validate) BlackoutUseCase {
+	return &BlackoutUseCaseImpl{
+		BlackoutRepository: blackoutRepository,
+		ScheduleRepository: scheduleRepository,
+		FieldRepository:    fieldRepository,
+		TxManager:          txManager,
+		validate:           validate,
+	}
+}
+
+// Save implements BlackoutUseCase. A blackout over sold schedules is refused
+// unless the request is forced, in which case the sold schedules are returned
+// so the operator can settle them with their buyers.
+func (service *BlackoutUseCaseImpl) Save(ctx context.Context, caller *dto.Caller, request *dto.BlackoutRequest) (*entity.Blackout, *[]entity.Schedule, error) {
+
+	if err := service.validate.Struct(request); err != nil {
+		return nil, nil, err
+	}
+
+	var response *entity.Blackout
+	conflicts := make([]entity.Schedule, 0)
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		field, err := service.FieldRepository.FindById(ctx, request.FieldId)
+		if err != nil {
+			return err
+		}
+
+		if !canMutateField(caller, field) {
+			return helper.ErrPermissionDenied
+		}
+
+		schedules, err := service.ScheduleRepository.FindByField(ctx, request.FieldId, request.StartAt, request.EndAt)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range *schedules {
+			if s.Status == entity.ScheduleStatusSold {
+				conflicts = append(conflicts, s)
+			}
+		}
+
+		if len(conflicts) > 0 && !request.Force {
+			return fmt.Errorf("%w: %d sold schedules in window", helper.ErrBlackoutConflict, len(conflicts))
+		}
+
+		blackoutData := entity.Blackout{
+			FieldId: request.FieldId,
+			StartAt: request.StartAt,
+			EndAt:   request.EndAt,
+			Reason:  request.Reason,
+		}
+
+		response, err = service.BlackoutRepository.Save(ctx, &blackoutData)
+		return err
+	})
+	if errors.Is(err, helper.ErrBlackoutConflict) {
+		return nil, &conflicts, err
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, &conflicts, nil
+}
+
+// Delete implements BlackoutUseCase
+func (service *BlackoutUseCaseImpl) Delete(ctx context.Context, caller *dto.Caller, blackoutId uint) error {
+
+	return service.TxManager.Do(ctx, func(ctx context.Context) error {
+		blackout, err := service.BlackoutRepository.FindById(ctx, blackoutId)
+		if err != nil {
+			return err
+		}
+
+		field, err := service.FieldRepository.FindById(ctx, blackout.FieldId)
+		if err != nil {
+			return err
+		}
+
+		if !canMutateField(caller, field) {
+			return helper.ErrPermissionDenied
+		}
+
+		if err := service.BlackoutRepository.Delete(ctx, blackoutId); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// FindByField implements BlackoutUseCase
+func (service *BlackoutUseCaseImpl) FindByField(ctx context.Context, filter *dto.ScheduleFilter) (*[]entity.Blackout, error) {
+
+	if err := service.validate.Struct(filter); err != nil {
+		return nil, err
+	}
+
+	blackouts, err := service.BlackoutRepository.FindByField(ctx, filter.FieldId, filter.StartDate, filter.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return blackouts, nil
+}
+
+// overlapsBlackout reports whether the slot [start, end) intersects any of blackouts.
+func overlapsBlackout(blackouts []entity.Blackout, start time.Time, end time.Time) bool {
+	for _, b := range blackouts {
+		if b.Overlaps(start, end) {
+			return true
+		}
+	}
+
+	return false
+}
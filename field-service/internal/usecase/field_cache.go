@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/cache"
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+)
+
+const fieldListGenerationKey = "fields:generation"
+
+// FieldCache keeps fields and field pages read by FieldUseCase. Pages are
+// keyed by a generation that every invalidation replaces, so one write drops
+// all cached pages without listing them. A read racing a write may store the
+// old field until the TTL ends; pages read under the old generation are never
+// served again.
+//
+// The cache is best effort: backend errors are logged and treated as misses.
+// A nil *FieldCache caches nothing.
+type FieldCache struct {
+	Cache   cache.Cache
+	TTL     time.Duration
+	counter cache.Counter
+}
+
+func NewFieldCache(c cache.Cache, ttl time.Duration) *FieldCache {
+	return &FieldCache{
+		Cache: c,
+		TTL:   ttl,
+	}
+}
+
+type fieldPage struct {
+	Fields      []entity.Field
+	TotalRecord int64
+}
+
+// Stats returns the hits and misses of field and page lookups.
+func (c *FieldCache) Stats() cache.Stats {
+	if c == nil {
+		return cache.Stats{}
+	}
+	return c.counter.Stats()
+}
+
+// Invalidate drops the given fields and every cached page. Call it once the
+// write transaction committed, otherwise a concurrent read may cache the
+// state before the write again.
+func (c *FieldCache) Invalidate(ctx context.Context, fieldIds ...uint) {
+	if c == nil {
+		return
+	}
+
+	keys := make([]string, 0, len(fieldIds))
+	for _, id := range fieldIds {
+		keys = append(keys, fieldKey(id))
+	}
+
+	if len(keys) > 0 {
+		if err := c.Cache.Delete(ctx, keys...); err != nil {
+			log.Printf("field cache: %v", err)
+		}
+	}
+
+	if _, err := c.newGeneration(ctx); err != nil {
+		log.Printf("field cache: %v", err)
+	}
+}
+
+func (c *FieldCache) field(ctx context.Context, fieldId uint) (*entity.Field, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	var field entity.Field
+	if !c.get(ctx, fieldKey(fieldId), &field) {
+		return nil, false
+	}
+	return &field, true
+}
+
+func (c *FieldCache) setField(ctx context.Context, field *entity.Field) {
+	if c == nil {
+		return
+	}
+
+	c.set(ctx, fieldKey(field.Id), field)
+}
+
+// pageKey returns the key of a page of fields, bound to the current
+// generation. It is taken before the page is read from the database so a
+// page read across an invalidation is stored under the old generation.
+func (c *FieldCache) pageKey(ctx context.Context, filter *dto.FieldFilter, limit uint32, page uint32) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	generation, err := c.generation(ctx)
+	if err != nil {
+		log.Printf("field cache: %v", err)
+		return "", false
+	}
+
+	data, err := json.Marshal(struct {
+		Filter *dto.FieldFilter
+		Limit  uint32
+		Page   uint32
+	}{filter, limit, page})
+	if err != nil {
+		log.Printf("field cache: %v", err)
+		return "", false
+	}
+
+	sum := sha256.Sum256(data)
+	return fmt.Sprintf("fields:%s:%s", generation, hex.EncodeToString(sum[:16])), true
+}
+
+func (c *FieldCache) page(ctx context.Context, key string) (*fieldPage, bool) {
+	var page fieldPage
+	if !c.get(ctx, key, &page) {
+		return nil, false
+	}
+	return &page, true
+}
+
+func (c *FieldCache) setPage(ctx context.Context, key string, page *fieldPage) {
+	c.set(ctx, key, page)
+}
+
+func (c *FieldCache) get(ctx context.Context, key string, value any) bool {
+	data, ok, err := c.Cache.Get(ctx, key)
+	if err != nil {
+		log.Printf("field cache: %v", err)
+	}
+
+	if !ok || err != nil || json.Unmarshal(data, value) != nil {
+		c.counter.Miss()
+		return false
+	}
+
+	c.counter.Hit()
+	return true
+}
+
+func (c *FieldCache) set(ctx context.Context, key string, value any) {
+	data, err := json.Marshal(value)
+	if err == nil {
+		err = c.Cache.Set(ctx, key, data, c.TTL)
+	}
+	if err != nil {
+		log.Printf("field cache: %v", err)
+	}
+}
+
+func (c *FieldCache) generation(ctx context.Context) (string, error) {
+	data, ok, err := c.Cache.Get(ctx, fieldListGenerationKey)
+	if err != nil {
+		return "", err
+	}
+
+	//an evicted generation just starts a new one
+	if !ok {
+		return c.newGeneration(ctx)
+	}
+	return string(data), nil
+}
+
+func (c *FieldCache) newGeneration(ctx context.Context) (string, error) {
+	generation := strconv.FormatInt(time.Now().UnixNano(), 36)
+	if err := c.Cache.Set(ctx, fieldListGenerationKey, []byte(generation), 0); err != nil {
+		return "", err
+	}
+	return generation, nil
+}
+
+func fieldKey(fieldId uint) string {
+	return "field:" + strconv.FormatUint(uint64(fieldId), 10)
+}
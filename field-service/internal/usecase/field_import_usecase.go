@@ -0,0 +1,275 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+// exportBatchSize is how many fields Export loads per query.
+const exportBatchSize = 500
+
+// errImportAborted rolls back an all or nothing import after a row failed.
+var errImportAborted = errors.New("import aborted")
+
+// Import implements FieldUseCase. Every row is validated first; in all or
+// nothing mode a single bad row rejects the whole file, in best effort mode
+// each valid row is saved on its own and the bad ones are reported.
+func (service *FieldUseCaseImpl) Import(ctx context.Context, caller *dto.Caller, request *dto.FieldImportRequest) (*dto.FieldImportResult, error) {
+
+	if err := service.validate.Struct(request); err != nil {
+		return nil, err
+	}
+
+	//only operators and super users may create fields
+	if _, err := fieldOperator(caller, &dto.FieldRequest{}); err != nil {
+		return nil, err
+	}
+
+	result := &dto.FieldImportResult{
+		TotalRows: uint32(len(request.Rows)),
+		Ids:       make([]uint, 0, len(request.Rows)),
+	}
+
+	valid := make([]dto.FieldImportRow, 0, len(request.Rows))
+	for _, row := range request.Rows {
+		if rowErrors := service.validateImportRow(&row); len(rowErrors) > 0 {
+			result.Errors = append(result.Errors, rowErrors...)
+			continue
+		}
+		valid = append(valid, row)
+	}
+
+	allOrNothing := request.Mode == dto.ImportModeAllOrNothing
+	if allOrNothing && len(result.Errors) > 0 {
+		return result, nil
+	}
+
+	venues := make(map[uint]*entity.Venue)
+
+	//imported fields show up in cached pages once their transactions are done
+	defer service.Cache.Invalidate(ctx)
+
+	if allOrNothing {
+		//rows are saved in one transaction that is rolled back if any of them fails
+		err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+			for _, row := range valid {
+				id, rowError, err := service.importRow(ctx, caller, &row, venues)
+				if err != nil {
+					return err
+				}
+				if rowError != nil {
+					result.Errors = append(result.Errors, *rowError)
+					return errImportAborted
+				}
+				result.Ids = append(result.Ids, id)
+			}
+			return nil
+		})
+		if err != nil {
+			result.Ids = result.Ids[:0]
+			if !errors.Is(err, errImportAborted) {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+
+	for _, row := range valid {
+		var id uint
+		var rowError *dto.FieldImportError
+		err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+			var err error
+			id, rowError, err = service.importRow(ctx, caller, &row, venues)
+			if err == nil && rowError != nil {
+				err = errImportAborted
+			}
+			return err
+		})
+		switch {
+		case rowError != nil:
+			result.Errors = append(result.Errors, *rowError)
+		case err != nil:
+			result.Errors = append(result.Errors, dto.FieldImportError{
+				Row:     row.Row,
+				Rule:    "save",
+				Message: err.Error(),
+			})
+		default:
+			result.Ids = append(result.Ids, id)
+		}
+	}
+
+	return result, nil
+}
+
+// validateImportRow returns the errors of a row that failed to decode or to
+// pass the field request validation.
+func (service *FieldUseCaseImpl) validateImportRow(row *dto.FieldImportRow) []dto.FieldImportError {
+	if row.Err != nil {
+		return []dto.FieldImportError{{
+			Row:     row.Row,
+			Rule:    "format",
+			Message: row.Err.Error(),
+		}}
+	}
+
+	err := service.validate.Struct(&row.Request)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return []dto.FieldImportError{{
+			Row:     row.Row,
+			Rule:    "validate",
+			Message: err.Error(),
+		}}
+	}
+
+	rowErrors := make([]dto.FieldImportError, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		rowErrors = append(rowErrors, dto.FieldImportError{
+			Row:     row.Row,
+			Field:   fieldError.Field(),
+			Rule:    fieldError.Tag(),
+			Message: fieldError.Error(),
+		})
+	}
+
+	return rowErrors
+}
+
+// importRow saves a validated row. A row referring to a missing venue or
+// parent, or rejected by the field rules Save applies, is reported as a row
+// error, anything else is returned as err.
+func (service *FieldUseCaseImpl) importRow(ctx context.Context, caller *dto.Caller, row *dto.FieldImportRow, venues map[uint]*entity.Venue) (uint, *dto.FieldImportError, error) {
+
+	request := &row.Request
+
+	var venue *entity.Venue
+	if request.VenueId != nil {
+		var checked bool
+		venue, checked = venues[*request.VenueId]
+		if !checked {
+			var err error
+			venue, err = service.VenueRepository.FindById(ctx, *request.VenueId)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return 0, nil, err
+			}
+			venues[*request.VenueId] = venue
+		}
+		if venue == nil {
+			return 0, &dto.FieldImportError{
+				Row:     row.Row,
+				Field:   "VenueId",
+				Rule:    "exists",
+				Message: fmt.Sprintf("venue %d not found", *request.VenueId),
+			}, nil
+		}
+	}
+
+	fieldData, err := service.newField(ctx, caller, request, venue)
+	if err != nil {
+		if rowError := importRowError(row.Row, err); rowError != nil {
+			return 0, rowError, nil
+		}
+		return 0, nil, err
+	}
+
+	response, err := service.FieldRepository.Save(ctx, fieldData)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if err := service.fieldCreated(ctx, response); err != nil {
+		return 0, nil, err
+	}
+
+	return response.Id, nil, nil
+}
+
+// importRowError returns the row error reporting err of row, or nil if err is
+// not caused by the row itself.
+func importRowError(row uint32, err error) *dto.FieldImportError {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		//the only record newField looks up is the parent
+		return &dto.FieldImportError{
+			Row:     row,
+			Field:   "ParentId",
+			Rule:    "exists",
+			Message: "parent field not found",
+		}
+	}
+
+	var appErr *apperror.Error
+	if !errors.As(err, &appErr) {
+		return nil
+	}
+
+	rowError := &dto.FieldImportError{
+		Row:     row,
+		Message: appErr.Message,
+	}
+	switch appErr.Kind {
+	case apperror.KindInvalidArgument:
+		rowError.Rule = "validate"
+	case apperror.KindFailedPrecondition:
+		rowError.Rule = "precondition"
+	case apperror.KindPermissionDenied:
+		rowError.Rule = "permission"
+	default:
+		return nil
+	}
+	if len(appErr.Violations) > 0 {
+		rowError.Field = appErr.Violations[0].Field
+	}
+
+	return rowError
+}
+
+// Export implements FieldUseCase. Fields matching filter are passed to fn in
+// id order, one batch at a time, so the whole table is never held in memory.
+func (service *FieldUseCaseImpl) Export(ctx context.Context, filter *dto.FieldFilter, fn func(fields *[]entity.Field) error) error {
+
+	if err := service.validate.Struct(filter); err != nil {
+		return err
+	}
+
+	//batches are walked by id regardless of the requested sort
+	batchFilter := *filter
+	batchFilter.SortBy, batchFilter.SortOrder = "", ""
+
+	var cursor *dto.Cursor
+	for {
+		fields, hasMore, err := service.exportBatch(ctx, &batchFilter, cursor)
+		if err != nil {
+			return err
+		}
+
+		if len(*fields) > 0 {
+			if err := fn(fields); err != nil {
+				return err
+			}
+		}
+
+		if !hasMore {
+			return nil
+		}
+
+		last := (*fields)[len(*fields)-1]
+		cursor = &dto.Cursor{Id: last.Id}
+	}
+}
+
+func (service *FieldUseCaseImpl) exportBatch(ctx context.Context, filter *dto.FieldFilter, cursor *dto.Cursor) (*[]entity.Field, bool, error) {
+
+	return service.FieldRepository.FindAllByCursor(ctx, filter, cursor, exportBatchSize)
+}
@@ -2,126 +2,386 @@ package usecase
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
 	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
 	"github.com/DevisArya/learn-microservices/field-service/internal/helper"
+	"github.com/DevisArya/learn-microservices/field-service/internal/outbox"
 	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	outboxkit "github.com/DevisArya/learn-microservices/svckit/outbox"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
 	"github.com/go-playground/validator/v10"
-	"gorm.io/gorm"
 )
 
 type FieldUseCase interface {
-	Save(ctx context.Context, request *dto.FieldRequest) (*entity.Field, error)
-	Update(ctx context.Context, request *dto.FieldRequest, id uint) error
-	Delete(ctx context.Context, fieldId uint) error
+	Save(ctx context.Context, caller *dto.Caller, request *dto.FieldRequest) (*entity.Field, error)
+	Update(ctx context.Context, caller *dto.Caller, request *dto.FieldRequest, id uint) error
+	Delete(ctx context.Context, caller *dto.Caller, fieldId uint) error
 	FindById(ctx context.Context, fieldId uint) (*entity.Field, error)
-	FindAll(ctx context.Context, limit uint32, page uint32) (*[]entity.Field, *dto.PaginationResponse, error)
+	FindAll(ctx context.Context, filter *dto.FieldFilter, limit uint32, page uint32) (*[]entity.Field, *dto.PaginationResponse, error)
+	FindAllByCursor(ctx context.Context, filter *dto.FieldFilter, page *dto.CursorRequest) (*[]entity.Field, *dto.PaginationResponse, error)
+	SearchAvailability(ctx context.Context, filter *dto.AvailabilityFilter) (*[]entity.Field, error)
+	Restore(ctx context.Context, fieldId uint) error
+	FindAllDeleted(ctx context.Context, limit uint32, page uint32) (*[]entity.Field, *dto.PaginationResponse, error)
+	Purge(ctx context.Context, retention time.Duration) (int64, error)
+	Import(ctx context.Context, caller *dto.Caller, request *dto.FieldImportRequest) (*dto.FieldImportResult, error)
+	Export(ctx context.Context, filter *dto.FieldFilter, fn func(fields *[]entity.Field) error) error
 }
 
 type FieldUseCaseImpl struct {
-	FieldRepository repository.FieldRepository
-	DB              *gorm.DB
-	validate        *validator.Validate
+	FieldRepository  repository.FieldRepository
+	VenueRepository  repository.VenueRepository
+	OutboxRepository outboxkit.Repository
+	Cache            *FieldCache
+	TxManager        txmanager.Manager
+	validate         *validator.Validate
 }
 
-func NewFieldUseCase(FieldRepository repository.FieldRepository, DB *gorm.DB, validate *validator.Validate) FieldUseCase {
+func NewFieldUseCase(FieldRepository repository.FieldRepository, VenueRepository repository.VenueRepository, OutboxRepository outboxkit.Repository, Cache *FieldCache, TxManager txmanager.Manager, validate *validator.Validate) FieldUseCase {
 	return &FieldUseCaseImpl{
 		FieldRepository,
-		DB,
+		VenueRepository,
+		OutboxRepository,
+		Cache,
+		TxManager,
 		validate,
 	}
 }
 
 // Save implements FieldUseCase
-func (service *FieldUseCaseImpl) Save(ctx context.Context, request *dto.FieldRequest) (*entity.Field, error) {
+func (service *FieldUseCaseImpl) Save(ctx context.Context, caller *dto.Caller, request *dto.FieldRequest) (*entity.Field, error) {
 
 	if err := service.validate.Struct(request); err != nil {
 		return nil, err
 	}
 
-	tx := service.DB.Begin()
-	defer helper.CommitOrRollback(tx)
-
-	fieldData := entity.Field{
-		Name:  request.Name,
-		Type:  request.Type,
-		Price: uint32(request.Price),
-	}
-
-	response, err := service.FieldRepository.Save(ctx, tx, &fieldData)
+	var response *entity.Field
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		var venue *entity.Venue
+		if request.VenueId != nil {
+			venue, err = service.VenueRepository.FindById(ctx, *request.VenueId)
+			if err != nil {
+				return err
+			}
+		}
+
+		fieldData, err := service.newField(ctx, caller, request, venue)
+		if err != nil {
+			return err
+		}
+
+		response, err = service.FieldRepository.Save(ctx, fieldData)
+		if err != nil {
+			return err
+		}
+
+		return service.fieldCreated(ctx, response)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	service.Cache.Invalidate(ctx)
+
 	return response, nil
 }
 
-// Update implements FieldUseCase
-func (service *FieldUseCaseImpl) Update(ctx context.Context, request *dto.FieldRequest, id uint) error {
+// newField builds the field request describes for caller, checking its
+// operator, parent and booking bounds. venue is the loaded venue of the
+// request, if any. Save and Import create every field through it.
+func (service *FieldUseCaseImpl) newField(ctx context.Context, caller *dto.Caller, request *dto.FieldRequest, venue *entity.Venue) (*entity.Field, error) {
 
-	if err := service.validate.Struct(request); err != nil {
-		return err
+	operatorId, err := fieldOperator(caller, request)
+	if err != nil {
+		return nil, err
 	}
 
-	tx := service.DB.Begin()
-	defer helper.CommitOrRollback(tx)
+	if request.ParentId != nil {
+		if err := service.checkParent(ctx, caller, *request.ParentId, 0); err != nil {
+			return nil, err
+		}
+	}
 
-	if _, err := service.FieldRepository.FindById(ctx, tx, id); err != nil {
-		return err
+	bounds, err := bookingBounds(request, &entity.Field{
+		MinDuration: entity.DefaultMinDuration,
+		MaxDuration: entity.DefaultMaxDuration,
+		Granularity: entity.DefaultGranularity,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	fieldData := entity.Field{
-		Id:          id,
+	return &entity.Field{
 		Name:        request.Name,
 		Type:        request.Type,
 		Description: request.Description,
 		Price:       request.Price,
+		VenueId:     request.VenueId,
+		OperatorId:  operatorId,
+		ParentId:    request.ParentId,
+		Timezone:    fieldTimezone(request, venue),
+		MinDuration: bounds.MinDuration,
+		MaxDuration: bounds.MaxDuration,
+		Granularity: bounds.Granularity,
+	}, nil
+}
+
+// fieldCreated records in the outbox that field was created.
+func (service *FieldUseCaseImpl) fieldCreated(ctx context.Context, field *entity.Field) error {
+	event, err := outbox.NewEvent(outbox.EventFieldCreated, "field", field.Id, outbox.FieldCreated{
+		FieldId:  field.Id,
+		Name:     field.Name,
+		Type:     field.Type,
+		Price:    field.Price,
+		VenueId:  field.VenueId,
+		ParentId: field.ParentId,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = service.OutboxRepository.Save(ctx, event)
+	return err
+}
+
+// Update implements FieldUseCase
+func (service *FieldUseCaseImpl) Update(ctx context.Context, caller *dto.Caller, request *dto.FieldRequest, id uint) error {
+
+	if err := service.validate.Struct(request); err != nil {
+		return err
 	}
 
-	if err := service.FieldRepository.Update(ctx, tx, &fieldData); err != nil {
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		field, err := service.FieldRepository.FindById(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if !canMutateField(caller, field) {
+			return helper.ErrPermissionDenied
+		}
+
+		if request.VenueId != nil {
+			if _, err := service.VenueRepository.FindById(ctx, *request.VenueId); err != nil {
+				return err
+			}
+		}
+
+		if request.ParentId != nil {
+			if err := service.checkParent(ctx, caller, *request.ParentId, id); err != nil {
+				return err
+			}
+		}
+
+		bounds, err := bookingBounds(request, field)
+		if err != nil {
+			return err
+		}
+
+		fieldData := entity.Field{
+			Id:          id,
+			Name:        request.Name,
+			Type:        request.Type,
+			Description: request.Description,
+			Price:       request.Price,
+			VenueId:     request.VenueId,
+			ParentId:    request.ParentId,
+			Timezone:    request.Timezone,
+			MinDuration: bounds.MinDuration,
+			MaxDuration: bounds.MaxDuration,
+			Granularity: bounds.Granularity,
+		}
+
+		if err := service.FieldRepository.Update(ctx, &fieldData); err != nil {
+			return err
+		}
+
+		//a zero price is left unchanged by the update
+		if request.Price != 0 && request.Price != field.Price {
+			event, err := outbox.NewEvent(outbox.EventFieldPriceChanged, "field", id, outbox.FieldPriceChanged{
+				FieldId:  id,
+				OldPrice: field.Price,
+				NewPrice: request.Price,
+			})
+			if err != nil {
+				return err
+			}
+
+			if _, err := service.OutboxRepository.Save(ctx, event); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
+	service.Cache.Invalidate(ctx, id)
+
 	return nil
 }
 
 // Delete implements FieldUseCase
-func (service *FieldUseCaseImpl) Delete(ctx context.Context, fieldId uint) error {
+func (service *FieldUseCaseImpl) Delete(ctx context.Context, caller *dto.Caller, fieldId uint) error {
+
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		field, err := service.FieldRepository.FindById(ctx, fieldId)
+		if err != nil {
+			return err
+		}
+
+		if !canMutateField(caller, field) {
+			return helper.ErrPermissionDenied
+		}
 
-	tx := service.DB.Begin()
-	defer helper.CommitOrRollback(tx)
+		if err := service.FieldRepository.Delete(ctx, fieldId); err != nil {
+			return err
+		}
 
-	if _, err := service.FieldRepository.FindById(ctx, tx, fieldId); err != nil {
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
-	if err := service.FieldRepository.Delete(ctx, tx, fieldId); err != nil {
+	service.Cache.Invalidate(ctx, fieldId)
+
+	return nil
+}
+
+// checkParent verifies that field fieldId (zero for a new field) may become a
+// part of parentId. Divisible fields are one level deep: a parent can not be a
+// part itself and a field with parts can not become one.
+func (service *FieldUseCaseImpl) checkParent(ctx context.Context, caller *dto.Caller, parentId uint, fieldId uint) error {
+
+	if parentId == fieldId {
+		return apperror.Violation("ParentId", "field can not be a part of itself")
+	}
+
+	parent, err := service.FieldRepository.FindById(ctx, parentId)
+	if err != nil {
 		return err
 	}
 
+	if !canMutateField(caller, parent) {
+		return helper.ErrPermissionDenied
+	}
+
+	if parent.ParentId != nil {
+		return apperror.FailedPrecondition("parent field is a part itself")
+	}
+
+	if fieldId != 0 {
+		parts, err := service.FieldRepository.CountParts(ctx, fieldId)
+		if err != nil {
+			return err
+		}
+		if parts > 0 {
+			return apperror.FailedPrecondition("field with parts can not become a part")
+		}
+	}
+
 	return nil
 }
 
-// FindById implements FieldUseCase
+// bookingBounds lays the booking bounds set in request over those of current
+// and checks that together they still allow a booking.
+func bookingBounds(request *dto.FieldRequest, current *entity.Field) (*entity.Field, error) {
+	bounds := entity.Field{
+		MinDuration: current.MinDuration,
+		MaxDuration: current.MaxDuration,
+		Granularity: current.Granularity,
+	}
+	if request.MinDuration > 0 {
+		bounds.MinDuration = request.MinDuration
+	}
+	if request.MaxDuration > 0 {
+		bounds.MaxDuration = request.MaxDuration
+	}
+	if request.Granularity > 0 {
+		bounds.Granularity = request.Granularity
+	}
+
+	if bounds.MinDuration > bounds.MaxDuration {
+		return nil, apperror.Violation("MinDuration", "min duration must not exceed max duration")
+	}
+	if bounds.Granularity == 0 {
+		return nil, apperror.Violation("Granularity", "granularity must be greater than zero")
+	}
+	if bounds.MinDuration%bounds.Granularity != 0 || bounds.MaxDuration%bounds.Granularity != 0 {
+		return nil, apperror.InvalidArgument("min and max duration must be multiples of the %d minutes granularity", bounds.Granularity)
+	}
+
+	return &bounds, nil
+}
+
+// fieldTimezone returns the requested zone of a new field, else the zone of its
+// venue, else UTC.
+func fieldTimezone(request *dto.FieldRequest, venue *entity.Venue) string {
+	if request.Timezone != "" {
+		return request.Timezone
+	}
+	if venue != nil && venue.Timezone != "" {
+		return venue.Timezone
+	}
+	return time.UTC.String()
+}
+
+// fieldOperator returns the operator owning a field created by caller.
+// Operators own the fields they create, super users may assign one.
+func fieldOperator(caller *dto.Caller, request *dto.FieldRequest) (*uint, error) {
+	switch entity.Role(caller.Role) {
+	case entity.RoleOperator:
+		return &caller.UserId, nil
+	case entity.RoleSuperUser:
+		return request.OperatorId, nil
+	default:
+		return nil, helper.ErrPermissionDenied
+	}
+}
+
+// canMutateField reports whether caller is a super user or the operator owning field.
+func canMutateField(caller *dto.Caller, field *entity.Field) bool {
+	if entity.Role(caller.Role) == entity.RoleSuperUser {
+		return true
+	}
+
+	return entity.Role(caller.Role) == entity.RoleOperator && field.OperatorId != nil && *field.OperatorId == caller.UserId
+}
+
+// FindById implements FieldUseCase. The field is read through the cache.
 func (service *FieldUseCaseImpl) FindById(ctx context.Context, fieldId uint) (*entity.Field, error) {
 
-	tx := service.DB.Begin()
-	defer helper.CommitOrRollback(tx)
+	if field, ok := service.Cache.field(ctx, fieldId); ok {
+		return field, nil
+	}
 
-	field, err := service.FieldRepository.FindById(ctx, tx, fieldId)
+	field, err := service.FieldRepository.FindById(ctx, fieldId)
 	if err != nil {
 		return nil, err
 	}
 
+	service.Cache.setField(ctx, field)
+
 	return field, nil
 }
 
-// FindAll implements FieldUseCase
-func (service *FieldUseCaseImpl) FindAll(ctx context.Context, limit uint32, page uint32) (*[]entity.Field, *dto.PaginationResponse, error) {
+// FindAll implements FieldUseCase. Pages are read through the cache.
+func (service *FieldUseCaseImpl) FindAll(ctx context.Context, filter *dto.FieldFilter, limit uint32, page uint32) (*[]entity.Field, *dto.PaginationResponse, error) {
 
-	tx := service.DB.Begin()
-	defer helper.CommitOrRollback(tx)
+	if err := service.validate.Struct(filter); err != nil {
+		return nil, nil, err
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return nil, nil, apperror.Violation("MinPrice", "min price must not be greater than max price")
+	}
 
 	if page < 1 {
 		page = 1
@@ -131,15 +391,207 @@ func (service *FieldUseCaseImpl) FindAll(ctx context.Context, limit uint32, page
 		limit = 10
 	}
 
+	key, cacheable := service.Cache.pageKey(ctx, filter, limit, page)
+	if cacheable {
+		if cached, ok := service.Cache.page(ctx, key); ok {
+			return &cached.Fields, fieldPagination(cached.TotalRecord, limit, page), nil
+		}
+	}
+
 	offset := (page - 1) * limit
 
-	fields, totalRecord, err := service.FieldRepository.FindAll(ctx, tx, limit, offset)
+	//the page and its total are read from one snapshot
+	var fields *[]entity.Field
+	var totalRecord int64
+	err := service.TxManager.ReadOnly(ctx, func(ctx context.Context) error {
+		var err error
+		fields, totalRecord, err = service.FieldRepository.FindAll(ctx, filter, limit, offset)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if cacheable {
+		service.Cache.setPage(ctx, key, &fieldPage{Fields: *fields, TotalRecord: totalRecord})
+	}
+
+	return fields, fieldPagination(totalRecord, limit, page), nil
+}
+
+func fieldPagination(totalRecord int64, limit uint32, page uint32) *dto.PaginationResponse {
+	totalPage := (totalRecord + int64(limit) - 1) / int64(limit)
 
+	return &dto.PaginationResponse{
+		CurrentPage: page,
+		Limit:       limit,
+		TotalRecord: uint32(totalRecord),
+		TotalPage:   uint32(totalPage),
+	}
+}
+
+// FindAllByCursor implements FieldUseCase
+func (service *FieldUseCaseImpl) FindAllByCursor(ctx context.Context, filter *dto.FieldFilter, page *dto.CursorRequest) (*[]entity.Field, *dto.PaginationResponse, error) {
+
+	if err := service.validate.Struct(filter); err != nil {
+		return nil, nil, err
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return nil, nil, apperror.Violation("MinPrice", "min price must not be greater than max price")
+	}
+
+	limit := page.Limit
+	if limit < 1 {
+		limit = 10
+	}
+
+	//cursor only valid for the sort it was issued for
+	sortBy, sortOrder := filter.SortBy, filter.SortOrder
+	if sortBy == "" {
+		sortOrder = ""
+	}
+
+	var cursor *dto.Cursor
+	if page.Cursor != "" {
+		decoded, err := helper.DecodeCursor(page.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+		if decoded.SortBy != sortBy || decoded.SortOrder != sortOrder {
+			return nil, nil, apperror.Violation("Cursor", "cursor does not match the requested sort")
+		}
+		cursor = decoded
+	}
+
+	var fields *[]entity.Field
+	var hasMore bool
+	var totalRecord int64
+	err := service.TxManager.ReadOnly(ctx, func(ctx context.Context) error {
+		var err error
+		fields, hasMore, err = service.FieldRepository.FindAllByCursor(ctx, filter, cursor, limit)
+		if err != nil || !page.WithCount {
+			return err
+		}
+
+		totalRecord, err = service.FieldRepository.Count(ctx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paging := dto.PaginationResponse{
+		Limit: limit,
+	}
+
+	if n := len(*fields); n > 0 {
+		backward := cursor != nil && cursor.Prev
+
+		if hasMore || backward {
+			paging.NextCursor = helper.EncodeCursor(fieldCursor(&(*fields)[n-1], sortBy, sortOrder, false))
+		}
+		if (hasMore && backward) || (cursor != nil && !backward) {
+			paging.PrevCursor = helper.EncodeCursor(fieldCursor(&(*fields)[0], sortBy, sortOrder, true))
+		}
+	}
+
+	if page.WithCount {
+		paging.TotalRecord = uint32(totalRecord)
+	}
+
+	return fields, &paging, nil
+}
+
+func fieldCursor(field *entity.Field, sortBy, sortOrder string, prev bool) *dto.Cursor {
+	cursor := dto.Cursor{
+		SortBy:    sortBy,
+		SortOrder: sortOrder,
+		Id:        field.Id,
+		Prev:      prev,
+	}
+
+	switch sortBy {
+	case "price":
+		cursor.Value = strconv.FormatUint(uint64(field.Price), 10)
+	case "name":
+		cursor.Value = field.Name
+	case "created_at":
+		cursor.Value = field.CreatedAt.Format(time.RFC3339Nano)
+	case "rating":
+		cursor.Value = strconv.FormatFloat(field.RatingAverage, 'g', -1, 64)
+	}
+
+	return &cursor
+}
+
+// SearchAvailability implements FieldUseCase
+func (service *FieldUseCaseImpl) SearchAvailability(ctx context.Context, filter *dto.AvailabilityFilter) (*[]entity.Field, error) {
+
+	if err := service.validate.Struct(filter); err != nil {
+		return nil, err
+	}
+
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MinPrice > *filter.MaxPrice {
+		return nil, apperror.Violation("MinPrice", "min price must not be greater than max price")
+	}
+
+	fields, err := service.FieldRepository.FindAvailable(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// Restore implements FieldUseCase
+func (service *FieldUseCaseImpl) Restore(ctx context.Context, fieldId uint) error {
+
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		if _, err := service.FieldRepository.FindDeletedById(ctx, fieldId); err != nil {
+			return err
+		}
+
+		if err := service.FieldRepository.Restore(ctx, fieldId); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	service.Cache.Invalidate(ctx, fieldId)
+
+	return nil
+}
+
+// FindAllDeleted implements FieldUseCase
+func (service *FieldUseCaseImpl) FindAllDeleted(ctx context.Context, limit uint32, page uint32) (*[]entity.Field, *dto.PaginationResponse, error) {
+
+	if page < 1 {
+		page = 1
+	}
+
+	if limit < 1 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	var fields *[]entity.Field
+	var totalRecord int64
+	err := service.TxManager.ReadOnly(ctx, func(ctx context.Context) error {
+		var err error
+		fields, totalRecord, err = service.FieldRepository.FindAllDeleted(ctx, limit, offset)
+		return err
+	})
 	if err != nil {
 		return nil, nil, err
 	}
 
-	totalPage := totalRecord / int64(limit)
+	totalPage := (totalRecord + int64(limit) - 1) / int64(limit)
 
 	return fields, &dto.PaginationResponse{
 		CurrentPage: page,
@@ -148,3 +600,23 @@ func (service *FieldUseCaseImpl) FindAll(ctx context.Context, limit uint32, page
 		TotalPage:   uint32(totalPage),
 	}, nil
 }
+
+// Purge implements FieldUseCase. It permanently removes fields that were
+// soft deleted more than retention ago, except those whose schedules were
+// booked.
+func (service *FieldUseCaseImpl) Purge(ctx context.Context, retention time.Duration) (int64, error) {
+
+	var purged int64
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		purged, err = service.FieldRepository.Purge(ctx, time.Now().Add(-retention))
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	service.Cache.Invalidate(ctx)
+
+	return purged, nil
+}
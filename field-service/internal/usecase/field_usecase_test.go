@@ -0,0 +1,384 @@
+//go:build cgo
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/broadcast"
+	"github.com/DevisArya/learn-microservices/field-service/internal/cache"
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	outboxkit "github.com/DevisArya/learn-microservices/svckit/outbox"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+func TestFieldPurgeKeepsHistory(t *testing.T) {
+	db := newScheduleTestDB(t)
+	ctx := context.Background()
+
+	now := time.Now()
+	expired := now.Add(-48 * time.Hour)
+	recent := now.Add(-time.Hour)
+
+	fields := []struct {
+		id        uint
+		parentId  *uint
+		deletedAt *time.Time
+	}{
+		{id: 1, deletedAt: &expired}, // only an available slot
+		{id: 2, deletedAt: &expired}, // a sold slot
+		{id: 3, deletedAt: &expired}, // a released slot of an expired transaction
+		{id: 4, deletedAt: &expired}, // a reviewed slot
+		{id: 5, deletedAt: &expired}, // parent of 6
+		{id: 6, parentId: ptr(uint(5)), deletedAt: &expired},
+		{id: 7, deletedAt: &recent}, // within retention
+		{id: 8},                     // not deleted
+	}
+	for _, f := range fields {
+		if err := db.Exec("INSERT INTO fields (id, parent_id, deleted_at) VALUES (?, ?, ?)", f.id, f.parentId, f.deletedAt).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	date := now.Add(24 * time.Hour)
+	slots := map[uint]entity.ScheduleStatus{
+		1: entity.ScheduleStatusAvailable,
+		2: entity.ScheduleStatusSold,
+		3: entity.ScheduleStatusAvailable,
+		4: entity.ScheduleStatusSold,
+	}
+	scheduleIds := make(map[uint]uint)
+	scheduleRepo := repository.NewScheduleRepository(db)
+	for fieldId, status := range slots {
+		schedule := entity.Schedule{FieldId: fieldId, Date: date, EndDate: date.Add(time.Hour), Status: status}
+		if _, err := scheduleRepo.Save(ctx, &schedule); err != nil {
+			t.Fatal(err)
+		}
+		scheduleIds[fieldId] = schedule.Id
+	}
+
+	for _, stmt := range []struct {
+		sql  string
+		args []any
+	}{
+		{"INSERT INTO transactions (transaction_id, user_id, payment_status, total_price) VALUES ('T1', 1, ?, 0)", []any{entity.PaymentStatusExpired}},
+		{"INSERT INTO transaction_details (transaction_id, schedule_id, name, price) VALUES ('T1', ?, 'slot', 0)", []any{scheduleIds[3]}},
+		{"INSERT INTO reviews (field_id, schedule_id, user_id, rating) VALUES (4, ?, 1, 5)", []any{scheduleIds[4]}},
+	} {
+		if err := db.Exec(stmt.sql, stmt.args...).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	service := NewFieldUseCase(repository.NewFieldRepository(db), repository.NewVenueRepository(db), nil, nil, txmanager.NewManager(db), validator.New())
+
+	remaining := func() []uint {
+		t.Helper()
+
+		var ids []uint
+		if err := db.Table("fields").Order("id ASC").Pluck("id", &ids).Error; err != nil {
+			t.Fatal(err)
+		}
+		return ids
+	}
+
+	purged, err := service.Purge(ctx, 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 2 {
+		t.Errorf("first run purged %d fields, want 2", purged)
+	}
+	if got, want := remaining(), []uint{2, 3, 4, 5, 7, 8}; !slices.Equal(got, want) {
+		t.Fatalf("after the first run fields %v remain, want %v", got, want)
+	}
+
+	var schedules int64
+	if err := db.Table("schedules").Where("field_id = ?", 1).Count(&schedules).Error; err != nil {
+		t.Fatal(err)
+	}
+	if schedules != 0 {
+		t.Errorf("purged field 1 still has %d schedules", schedules)
+	}
+
+	//the parent goes once its part is gone
+	purged, err = service.Purge(ctx, 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purged != 1 {
+		t.Errorf("second run purged %d fields, want 1", purged)
+	}
+	if got, want := remaining(), []uint{2, 3, 4, 7, 8}; !slices.Equal(got, want) {
+		t.Fatalf("after the second run fields %v remain, want %v", got, want)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestReserveOnDeletedField(t *testing.T) {
+	db := newScheduleTestDB(t)
+	ctx := context.Background()
+
+	if err := db.Exec("INSERT INTO fields (id, operator_id) VALUES (1, 7)").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	future := now.Add(24 * time.Hour)
+	past := now.Add(-24 * time.Hour)
+	scheduleRepo := repository.NewScheduleRepository(db)
+	schedules := map[string]*entity.Schedule{
+		"open":       {FieldId: 1, Date: future, EndDate: future.Add(time.Hour), Status: entity.ScheduleStatusAvailable},
+		"generated":  {FieldId: 1, Date: future.Add(time.Hour), EndDate: future.Add(2 * time.Hour), Status: entity.ScheduleStatusAvailable, Generated: true},
+		"referenced": {FieldId: 1, Date: future.Add(2 * time.Hour), EndDate: future.Add(3 * time.Hour), Status: entity.ScheduleStatusAvailable},
+		"sold":       {FieldId: 1, Date: future.Add(3 * time.Hour), EndDate: future.Add(4 * time.Hour), Status: entity.ScheduleStatusSold, UserId: ptr(uint(1))},
+		"past":       {FieldId: 1, Date: past, EndDate: past.Add(time.Hour), Status: entity.ScheduleStatusAvailable},
+	}
+	for _, schedule := range schedules {
+		if _, err := scheduleRepo.Save(ctx, schedule); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.Exec("INSERT INTO transaction_details (transaction_id, schedule_id, name, price) VALUES ('T1', ?, 'slot', 0)", schedules["referenced"].Id).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	fieldRepo := repository.NewFieldRepository(db)
+	txManager := txmanager.NewManager(db)
+	fieldUc := NewFieldUseCase(fieldRepo, repository.NewVenueRepository(db), nil, nil, txManager, validator.New())
+	if err := fieldUc.Delete(ctx, &dto.Caller{UserId: 7, Role: string(entity.RoleOperator)}, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	var remaining []uint
+	if err := db.Table("schedules").Order("id ASC").Pluck("id", &remaining).Error; err != nil {
+		t.Fatal(err)
+	}
+	want := []uint{schedules["referenced"].Id, schedules["sold"].Id, schedules["past"].Id}
+	slices.Sort(want)
+	if !slices.Equal(remaining, want) {
+		t.Fatalf("schedules %v remain, want the referenced, sold and past ones %v", remaining, want)
+	}
+
+	scheduleUc := NewScheduleUseCase(scheduleRepo, fieldRepo, repository.NewBlackoutRepository(db), outboxkit.NewRepository(db), repository.NewTransactionRepository(db), broadcast.NewScheduleBroadcaster(0, 1), txManager, validator.New(), 15*time.Minute)
+	err := scheduleUc.UpdateStatus(ctx, &dto.Caller{UserId: 2, Role: string(entity.RoleUser)}, &dto.ScheduleStatusRequest{
+		Status: string(entity.ScheduleStatusReserved),
+	}, schedules["referenced"].Id)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("reserve on a deleted field: got %v, want the field not found", err)
+	}
+}
+
+func TestFieldFindAllByCursor(t *testing.T) {
+	db := newScheduleTestDB(t)
+	ctx := context.Background()
+
+	//three fields share a price, ties are broken by id
+	for id, price := range []uint32{100, 200, 200, 200, 300} {
+		if err := db.Exec("INSERT INTO fields (id, name, price) VALUES (?, ?, ?)", id+1, fmt.Sprintf("field %d", id+1), price).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	service := NewFieldUseCase(repository.NewFieldRepository(db), repository.NewVenueRepository(db), nil, nil, txmanager.NewManager(db), validator.New())
+
+	type page struct {
+		ids           []uint
+		hasPrev       bool
+		hasNext       bool
+		followsCursor string // next or prev, how the page is reached from the one before
+	}
+
+	tests := []struct {
+		name      string
+		sortBy    string
+		sortOrder string
+		limit     uint32
+		pages     []page
+	}{
+		{
+			name:  "single page",
+			limit: 10,
+			pages: []page{
+				{ids: []uint{1, 2, 3, 4, 5}},
+			},
+		},
+		{
+			name:  "forward by id",
+			limit: 2,
+			pages: []page{
+				{ids: []uint{1, 2}, hasNext: true},
+				{ids: []uint{3, 4}, hasPrev: true, hasNext: true, followsCursor: "next"},
+				{ids: []uint{5}, hasPrev: true, followsCursor: "next"},
+			},
+		},
+		{
+			name:  "backward from the last page",
+			limit: 2,
+			pages: []page{
+				{ids: []uint{1, 2}, hasNext: true},
+				{ids: []uint{3, 4}, hasPrev: true, hasNext: true, followsCursor: "next"},
+				{ids: []uint{5}, hasPrev: true, followsCursor: "next"},
+				{ids: []uint{3, 4}, hasPrev: true, hasNext: true, followsCursor: "prev"},
+				{ids: []uint{1, 2}, hasNext: true, followsCursor: "prev"},
+				{ids: []uint{3, 4}, hasPrev: true, hasNext: true, followsCursor: "next"},
+			},
+		},
+		{
+			name:      "equal prices ascending",
+			sortBy:    "price",
+			sortOrder: "asc",
+			limit:     2,
+			pages: []page{
+				{ids: []uint{1, 2}, hasNext: true},
+				{ids: []uint{3, 4}, hasPrev: true, hasNext: true, followsCursor: "next"},
+				{ids: []uint{5}, hasPrev: true, followsCursor: "next"},
+				{ids: []uint{3, 4}, hasPrev: true, hasNext: true, followsCursor: "prev"},
+			},
+		},
+		{
+			name:      "equal prices descending",
+			sortBy:    "price",
+			sortOrder: "desc",
+			limit:     2,
+			pages: []page{
+				{ids: []uint{5, 4}, hasNext: true},
+				{ids: []uint{3, 2}, hasPrev: true, hasNext: true, followsCursor: "next"},
+				{ids: []uint{1}, hasPrev: true, followsCursor: "next"},
+				{ids: []uint{3, 2}, hasPrev: true, hasNext: true, followsCursor: "prev"},
+				{ids: []uint{5, 4}, hasNext: true, followsCursor: "prev"},
+			},
+		},
+		{
+			name:      "equal prices across a page boundary",
+			sortBy:    "price",
+			sortOrder: "asc",
+			limit:     3,
+			pages: []page{
+				{ids: []uint{1, 2, 3}, hasNext: true},
+				{ids: []uint{4, 5}, hasPrev: true, followsCursor: "next"},
+				{ids: []uint{1, 2, 3}, hasNext: true, followsCursor: "prev"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := &dto.FieldFilter{SortBy: tt.sortBy, SortOrder: tt.sortOrder}
+
+			var paging *dto.PaginationResponse
+			for i, want := range tt.pages {
+				request := &dto.CursorRequest{Limit: tt.limit}
+				switch want.followsCursor {
+				case "next":
+					request.Cursor = paging.NextCursor
+				case "prev":
+					request.Cursor = paging.PrevCursor
+				}
+
+				fields, got, err := service.FindAllByCursor(ctx, filter, request)
+				if err != nil {
+					t.Fatalf("page %d: %v", i, err)
+				}
+				paging = got
+
+				ids := make([]uint, 0, len(*fields))
+				for _, f := range *fields {
+					ids = append(ids, f.Id)
+				}
+				if !slices.Equal(ids, want.ids) {
+					t.Fatalf("page %d: got fields %v, want %v", i, ids, want.ids)
+				}
+				if hasPrev := paging.PrevCursor != ""; hasPrev != want.hasPrev {
+					t.Errorf("page %d: has prev cursor %t, want %t", i, hasPrev, want.hasPrev)
+				}
+				if hasNext := paging.NextCursor != ""; hasNext != want.hasNext {
+					t.Errorf("page %d: has next cursor %t, want %t", i, hasNext, want.hasNext)
+				}
+			}
+		})
+	}
+
+	t.Run("cursor of another sort", func(t *testing.T) {
+		_, paging, err := service.FindAllByCursor(ctx, &dto.FieldFilter{SortBy: "price", SortOrder: "asc"}, &dto.CursorRequest{Limit: 2})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, _, err = service.FindAllByCursor(ctx, &dto.FieldFilter{SortBy: "price", SortOrder: "desc"}, &dto.CursorRequest{Cursor: paging.NextCursor, Limit: 2})
+		if apperror.KindOf(err) != apperror.KindInvalidArgument {
+			t.Errorf("got %v, want an invalid cursor", err)
+		}
+	})
+}
+
+func TestFieldCacheInvalidatedByWrite(t *testing.T) {
+	db := newScheduleTestDB(t)
+	ctx := context.Background()
+
+	for id := 1; id <= 3; id++ {
+		if err := db.Exec("INSERT INTO fields (id, name, price) VALUES (?, ?, 100)", id, fmt.Sprintf("field %d", id)).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	fieldCache := NewFieldCache(cache.NewLRU(100), time.Hour)
+	service := NewFieldUseCase(repository.NewFieldRepository(db), repository.NewVenueRepository(db), outboxkit.NewRepository(db), fieldCache, txmanager.NewManager(db), validator.New())
+
+	findAll := func() []uint {
+		t.Helper()
+
+		fields, _, err := service.FindAll(ctx, &dto.FieldFilter{}, 10, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ids := make([]uint, 0, len(*fields))
+		for _, f := range *fields {
+			ids = append(ids, f.Id)
+		}
+		return ids
+	}
+
+	if ids := findAll(); !slices.Equal(ids, []uint{1, 2, 3}) {
+		t.Fatalf("got fields %v, want 1 2 3", ids)
+	}
+	if stats := fieldCache.Stats(); stats.Hits != 0 || stats.Misses != 1 {
+		t.Errorf("got %+v after the first read, want 1 miss", stats)
+	}
+
+	//a row written past the use case is not seen while the page is cached
+	if err := db.Exec("INSERT INTO fields (id, name, price) VALUES (4, 'field 4', 100)").Error; err != nil {
+		t.Fatal(err)
+	}
+	if ids := findAll(); !slices.Equal(ids, []uint{1, 2, 3}) {
+		t.Fatalf("got fields %v, want the cached page", ids)
+	}
+	if stats := fieldCache.Stats(); stats.Hits != 1 || stats.Misses != 1 {
+		t.Errorf("got %+v after the second read, want 1 hit", stats)
+	}
+
+	if err := service.Delete(ctx, &dto.Caller{UserId: 1, Role: string(entity.RoleSuperUser)}, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	if ids := findAll(); !slices.Equal(ids, []uint{1, 3, 4}) {
+		t.Fatalf("got fields %v after the delete, want 1 3 4", ids)
+	}
+	if stats := fieldCache.Stats(); stats.Hits != 1 || stats.Misses != 2 {
+		t.Errorf("got %+v after the delete, want the page read again", stats)
+	}
+}
@@ -0,0 +1,288 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/helper"
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+)
+
+type PricingUseCase interface {
+	Save(ctx context.Context, caller *dto.Caller, request *dto.PricingRuleRequest) (*entity.PricingRule, error)
+	Delete(ctx context.Context, caller *dto.Caller, ruleId uint) error
+	FindByField(ctx context.Context, fieldId uint) (*[]entity.PricingRule, error)
+	Quote(ctx context.Context, scheduleIds []uint) (*[]dto.PriceQuote, error)
+}
+
+type PricingUseCaseImpl struct {
+	PricingRuleRepository repository.PricingRuleRepository
+	ScheduleRepository    repository.ScheduleRepository
+	FieldRepository       repository.FieldRepository
+	TxManager             txmanager.Manager
+	validate              *validator.Validate
+}
+
+func NewPricingUseCase(pricingRuleRepository repository.PricingRuleRepository, scheduleRepository repository.ScheduleRepository, fieldRepository repository.FieldRepository, txManager txmanager.Manager, validate *validator.Validate) PricingUseCase {
+	return &PricingUseCaseImpl{
+		PricingRuleRepository: pricingRuleRepository,
+		ScheduleRepository:    scheduleRepository,
+		FieldRepository:       fieldRepository,
+		TxManager:             txManager,
+		validate:              validate,
+	}
+}
+
+// Save implements PricingUseCase
+func (service *PricingUseCaseImpl) Save(ctx context.Context, caller *dto.Caller, request *dto.PricingRuleRequest) (*entity.PricingRule, error) {
+
+	if err := service.validate.Struct(request); err != nil {
+		return nil, err
+	}
+
+	//an end before the start is a window crossing midnight, e.g. 22:00-02:00
+	if request.StartTime != "" && request.StartTime == request.EndTime {
+		return nil, apperror.Violation("EndTime", "end time must differ from start time")
+	}
+
+	if request.ValidFrom != nil && request.ValidUntil != nil && !request.ValidUntil.After(*request.ValidFrom) {
+		return nil, apperror.Violation("ValidUntil", "valid until must be after valid from")
+	}
+
+	kind := entity.PricingRuleKind(request.Kind)
+	if kind == entity.PricingRuleOverride && request.Price == 0 {
+		return nil, apperror.Violation("Price", "override rule requires a price")
+	}
+	if kind == entity.PricingRuleSurcharge && request.Percent == 0 {
+		return nil, apperror.Violation("Percent", "surcharge rule requires a percent")
+	}
+
+	var weekdays uint8
+	for _, day := range request.Weekdays {
+		weekdays |= 1 << day
+	}
+
+	ruleData := entity.PricingRule{
+		FieldId:    request.FieldId,
+		Name:       request.Name,
+		Kind:       kind,
+		Weekdays:   weekdays,
+		StartTime:  request.StartTime,
+		EndTime:    request.EndTime,
+		ValidFrom:  request.ValidFrom,
+		ValidUntil: request.ValidUntil,
+		Priority:   request.Priority,
+	}
+
+	if kind == entity.PricingRuleOverride {
+		ruleData.Price = request.Price
+	} else {
+		ruleData.Percent = request.Percent
+	}
+
+	var response *entity.PricingRule
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		field, err := service.FieldRepository.FindById(ctx, request.FieldId)
+		if err != nil {
+			return err
+		}
+
+		if !canMutateField(caller, field) {
+			return helper.ErrPermissionDenied
+		}
+
+		response, err = service.PricingRuleRepository.Save(ctx, &ruleData)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Delete implements PricingUseCase
+func (service *PricingUseCaseImpl) Delete(ctx context.Context, caller *dto.Caller, ruleId uint) error {
+
+	return service.TxManager.Do(ctx, func(ctx context.Context) error {
+		rule, err := service.PricingRuleRepository.FindById(ctx, ruleId)
+		if err != nil {
+			return err
+		}
+
+		field, err := service.FieldRepository.FindById(ctx, rule.FieldId)
+		if err != nil {
+			return err
+		}
+
+		if !canMutateField(caller, field) {
+			return helper.ErrPermissionDenied
+		}
+
+		if err := service.PricingRuleRepository.Delete(ctx, ruleId); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// FindByField implements PricingUseCase
+func (service *PricingUseCaseImpl) FindByField(ctx context.Context, fieldId uint) (*[]entity.PricingRule, error) {
+
+	rules, err := service.PricingRuleRepository.FindByField(ctx, fieldId)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// Quote implements PricingUseCase
+func (service *PricingUseCaseImpl) Quote(ctx context.Context, scheduleIds []uint) (*[]dto.PriceQuote, error) {
+
+	if len(scheduleIds) == 0 {
+		return nil, apperror.Violation("ScheduleIds", "schedule ids is required")
+	}
+
+	seen := make(map[uint]bool, len(scheduleIds))
+	uniqueIds := make([]uint, 0, len(scheduleIds))
+	for _, id := range scheduleIds {
+		if !seen[id] {
+			seen[id] = true
+			uniqueIds = append(uniqueIds, id)
+		}
+	}
+
+	var quotes []dto.PriceQuote
+	err := service.TxManager.ReadOnly(ctx, func(ctx context.Context) error {
+		schedules, err := service.ScheduleRepository.FindByIds(ctx, uniqueIds)
+		if err != nil {
+			return err
+		}
+
+		if len(*schedules) != len(uniqueIds) {
+			return apperror.NotFound("schedule not found")
+		}
+
+		fields := make(map[uint]*entity.Field)
+		rules := make(map[uint][]entity.PricingRule)
+
+		quotes = make([]dto.PriceQuote, 0, len(*schedules))
+		for _, s := range *schedules {
+			field, ok := fields[s.FieldId]
+			if !ok {
+				field, err = service.FieldRepository.FindById(ctx, s.FieldId)
+				if err != nil {
+					return err
+				}
+				fieldRules, err := service.PricingRuleRepository.FindByField(ctx, s.FieldId)
+				if err != nil {
+					return err
+				}
+				fields[s.FieldId] = field
+				rules[s.FieldId] = *fieldRules
+			}
+
+			quotes = append(quotes, quoteSlot(field, rules[s.FieldId], &s))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &quotes, nil
+}
+
+// quoteSlot prices a slot starting from the field price, an hourly rate
+// prorated to the slot duration. The matching override with the highest
+// priority replaces the hourly rate, then every matching surcharge adds its
+// percent of that base. Rules are matched against the slot start in the field
+// time zone. Discounts together never take more than 99% off the base, so a
+// slot with a price is never free.
+func quoteSlot(field *entity.Field, rules []entity.PricingRule, schedule *entity.Schedule) dto.PriceQuote {
+	date := schedule.Date.In(field.Location())
+	duration := schedule.Duration()
+	base := int64(entity.ProratedPrice(field.Price, duration))
+	lines := []dto.PriceLine{{Description: fmt.Sprintf("base price (%d min)", int64(duration/time.Minute)), Amount: base}}
+
+	for _, rule := range rules {
+		if rule.Kind == entity.PricingRuleOverride && pricingRuleMatches(&rule, date) {
+			price := int64(entity.ProratedPrice(rule.Price, duration))
+			lines = append(lines, dto.PriceLine{
+				Description: fmt.Sprintf("override: %s", rule.Name),
+				Amount:      price - base,
+			})
+			base = price
+			break
+		}
+	}
+
+	total := base
+	for _, rule := range rules {
+		if rule.Kind == entity.PricingRuleSurcharge && pricingRuleMatches(&rule, date) {
+			amount := base * int64(rule.Percent) / 100
+			lines = append(lines, dto.PriceLine{
+				Description: fmt.Sprintf("surcharge: %s (%+d%%)", rule.Name, rule.Percent),
+				Amount:      amount,
+			})
+			total += amount
+		}
+	}
+
+	if floor := (base + 99) / 100; total < floor {
+		total = floor
+	}
+
+	return dto.PriceQuote{
+		ScheduleId: schedule.Id,
+		FieldId:    schedule.FieldId,
+		Date:       date,
+		Lines:      lines,
+		Total:      uint32(total),
+	}
+}
+
+// pricingRuleMatches reports whether rule applies to a slot starting at date.
+// A window whose end is before its start crosses midnight; its early hours
+// count for the weekday the window started on, so a friday 22:00-02:00 rule
+// also matches saturday 01:00.
+func pricingRuleMatches(rule *entity.PricingRule, date time.Time) bool {
+	weekday := date.Weekday()
+
+	if rule.StartTime != "" {
+		clock := date.Format("15:04")
+		switch {
+		case rule.StartTime < rule.EndTime:
+			if clock < rule.StartTime || clock >= rule.EndTime {
+				return false
+			}
+		case clock >= rule.StartTime:
+		case clock < rule.EndTime:
+			weekday = (weekday + 6) % 7
+		default:
+			return false
+		}
+	}
+
+	if rule.Weekdays != 0 && rule.Weekdays&(1<<uint(weekday)) == 0 {
+		return false
+	}
+
+	if rule.ValidFrom != nil && date.Before(*rule.ValidFrom) {
+		return false
+	}
+	if rule.ValidUntil != nil && !date.Before(*rule.ValidUntil) {
+		return false
+	}
+
+	return true
+}
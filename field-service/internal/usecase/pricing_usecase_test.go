@@ -0,0 +1,127 @@
+//go:build cgo
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/helper"
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestPricingRuleRequiresFieldOwner(t *testing.T) {
+	db := newScheduleTestDB(t)
+	ctx := context.Background()
+
+	if err := db.Exec("INSERT INTO fields (id, operator_id, price) VALUES (1, 7, 1000)").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	service := NewPricingUseCase(repository.NewPricingRuleRepository(db), repository.NewScheduleRepository(db), repository.NewFieldRepository(db), txmanager.NewManager(db), validator.New())
+
+	owner := &dto.Caller{UserId: 7, Role: string(entity.RoleOperator)}
+	request := &dto.PricingRuleRequest{FieldId: 1, Name: "members", Kind: string(entity.PricingRuleSurcharge), Percent: -50}
+
+	for _, caller := range []*dto.Caller{
+		{UserId: 8, Role: string(entity.RoleOperator)},
+		{UserId: 7, Role: string(entity.RoleUser)},
+	} {
+		if _, err := service.Save(ctx, caller, request); !errors.Is(err, helper.ErrPermissionDenied) {
+			t.Errorf("save by %s %d: got %v, want permission denied", caller.Role, caller.UserId, err)
+		}
+	}
+
+	rule, err := service.Save(ctx, owner, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stranger := &dto.Caller{UserId: 8, Role: string(entity.RoleOperator)}
+	if err := service.Delete(ctx, stranger, rule.Id); !errors.Is(err, helper.ErrPermissionDenied) {
+		t.Errorf("delete by another operator: got %v, want permission denied", err)
+	}
+	if err := service.Delete(ctx, &dto.Caller{UserId: 1, Role: string(entity.RoleSuperUser)}, rule.Id); err != nil {
+		t.Errorf("delete by a super user: %v", err)
+	}
+
+	//a rule may not make the field free on its own
+	request.Percent = -100
+	if _, err := service.Save(ctx, owner, request); err == nil {
+		t.Error("saved a -100% surcharge")
+	}
+
+	//a window may cross midnight but not be empty
+	request.Percent = 20
+	request.StartTime, request.EndTime = "22:00", "02:00"
+	if _, err := service.Save(ctx, owner, request); err != nil {
+		t.Errorf("save a window crossing midnight: %v", err)
+	}
+	request.EndTime = "22:00"
+	if _, err := service.Save(ctx, owner, request); err == nil {
+		t.Error("saved an empty window")
+	}
+}
+
+func TestQuoteSlotNeverFree(t *testing.T) {
+	field := &entity.Field{Id: 1, Price: 1000}
+	date := time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)
+	schedule := &entity.Schedule{Id: 1, FieldId: 1, Date: date, EndDate: date.Add(time.Hour)}
+
+	rules := []entity.PricingRule{
+		{Name: "members", Kind: entity.PricingRuleSurcharge, Percent: -99},
+		{Name: "happy hour", Kind: entity.PricingRuleSurcharge, Percent: -99},
+	}
+
+	if quote := quoteSlot(field, rules, schedule); quote.Total != 10 {
+		t.Errorf("stacked discounts quote %d, want the 1%% floor of 10", quote.Total)
+	}
+}
+
+func TestPricingRuleMatchesWindow(t *testing.T) {
+	friday := uint8(1 << time.Friday)
+
+	// 2025-06-06 is a friday
+	at := func(day int, clock string) time.Time {
+		t.Helper()
+
+		date, err := time.Parse("2006-01-02 15:04", fmt.Sprintf("2025-06-%02d %s", day, clock))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return date
+	}
+
+	tests := []struct {
+		name  string
+		rule  entity.PricingRule
+		date  time.Time
+		match bool
+	}{
+		{"inside a day window", entity.PricingRule{StartTime: "18:00", EndTime: "22:00"}, at(6, "18:00"), true},
+		{"at the end of a day window", entity.PricingRule{StartTime: "18:00", EndTime: "22:00"}, at(6, "22:00"), false},
+		{"before a day window", entity.PricingRule{StartTime: "18:00", EndTime: "22:00"}, at(6, "17:30"), false},
+		{"before midnight of a night window", entity.PricingRule{StartTime: "22:00", EndTime: "02:00"}, at(6, "23:00"), true},
+		{"after midnight of a night window", entity.PricingRule{StartTime: "22:00", EndTime: "02:00"}, at(7, "01:00"), true},
+		{"at the end of a night window", entity.PricingRule{StartTime: "22:00", EndTime: "02:00"}, at(7, "02:00"), false},
+		{"outside a night window", entity.PricingRule{StartTime: "22:00", EndTime: "02:00"}, at(6, "12:00"), false},
+		{"night window on its start day", entity.PricingRule{Weekdays: friday, StartTime: "22:00", EndTime: "02:00"}, at(6, "22:30"), true},
+		{"night window past midnight of its start day", entity.PricingRule{Weekdays: friday, StartTime: "22:00", EndTime: "02:00"}, at(7, "01:00"), true},
+		{"night window past midnight of another day", entity.PricingRule{Weekdays: friday, StartTime: "22:00", EndTime: "02:00"}, at(6, "01:00"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pricingRuleMatches(&tt.rule, tt.date); got != tt.match {
+				t.Errorf("match = %v, want %v", got, tt.match)
+			}
+		})
+	}
+}
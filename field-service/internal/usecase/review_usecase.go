@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/helper"
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+type ReviewUseCase interface {
+	Save(ctx context.Context, caller *dto.Caller, request *dto.ReviewRequest) (*entity.Review, error)
+	Delete(ctx context.Context, caller *dto.Caller, reviewId uint) error
+	FindByField(ctx context.Context, fieldId uint, limit uint32, page uint32) (*[]entity.Review, *dto.PaginationResponse, error)
+}
+
+type ReviewUseCaseImpl struct {
+	ReviewRepository   repository.ReviewRepository
+	ScheduleRepository repository.ScheduleRepository
+	FieldRepository    repository.FieldRepository
+	FieldCache         *FieldCache
+	TxManager          txmanager.Manager
+	validate           *validator.Validate
+}
+
+func NewReviewUseCase(reviewRepository repository.ReviewRepository, scheduleRepository repository.ScheduleRepository, fieldRepository repository.FieldRepository, fieldCache *FieldCache, txManager txmanager.Manager, validate *validator.Validate) ReviewUseCase {
+	return &ReviewUseCaseImpl{
+		ReviewRepository:   reviewRepository,
+		ScheduleRepository: scheduleRepository,
+		FieldRepository:    fieldRepository,
+		FieldCache:         fieldCache,
+		TxManager:          txManager,
+		validate:           validate,
+	}
+}
+
+// Save implements ReviewUseCase. A review is tied to a booking: the caller
+// must have bought the schedule, it must have started already, and each
+// booking can be reviewed once.
+func (service *ReviewUseCaseImpl) Save(ctx context.Context, caller *dto.Caller, request *dto.ReviewRequest) (*entity.Review, error) {
+
+	if err := service.validate.Struct(request); err != nil {
+		return nil, err
+	}
+
+	var response *entity.Review
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		schedule, err := service.ScheduleRepository.FindById(ctx, request.ScheduleId)
+		if err != nil {
+			return err
+		}
+
+		if schedule.UserId == nil || *schedule.UserId != caller.UserId {
+			return helper.ErrPermissionDenied
+		}
+
+		if schedule.Status != entity.ScheduleStatusSold || schedule.Date.After(time.Now()) {
+			return helper.ErrNotReviewable
+		}
+
+		_, err = service.ReviewRepository.FindBySchedule(ctx, schedule.Id)
+		if err == nil {
+			return helper.ErrAlreadyReviewed
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		reviewData := entity.Review{
+			FieldId:    schedule.FieldId,
+			ScheduleId: schedule.Id,
+			UserId:     caller.UserId,
+			Rating:     request.Rating,
+			Comment:    request.Comment,
+		}
+
+		response, err = service.ReviewRepository.Save(ctx, &reviewData)
+		if err != nil {
+			return err
+		}
+
+		return service.FieldRepository.RefreshRating(ctx, schedule.FieldId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	service.FieldCache.Invalidate(ctx, response.FieldId)
+
+	return response, nil
+}
+
+// Delete implements ReviewUseCase. Reviews may be removed by their author or
+// a super user.
+func (service *ReviewUseCaseImpl) Delete(ctx context.Context, caller *dto.Caller, reviewId uint) error {
+
+	var fieldId uint
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		review, err := service.ReviewRepository.FindById(ctx, reviewId)
+		if err != nil {
+			return err
+		}
+
+		if review.UserId != caller.UserId && entity.Role(caller.Role) != entity.RoleSuperUser {
+			return helper.ErrPermissionDenied
+		}
+
+		if err := service.ReviewRepository.Delete(ctx, reviewId); err != nil {
+			return err
+		}
+
+		fieldId = review.FieldId
+		return service.FieldRepository.RefreshRating(ctx, review.FieldId)
+	})
+	if err != nil {
+		return err
+	}
+
+	service.FieldCache.Invalidate(ctx, fieldId)
+
+	return nil
+}
+
+// FindByField implements ReviewUseCase
+func (service *ReviewUseCaseImpl) FindByField(ctx context.Context, fieldId uint, limit uint32, page uint32) (*[]entity.Review, *dto.PaginationResponse, error) {
+
+	if page < 1 {
+		page = 1
+	}
+
+	if limit < 1 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	var reviews *[]entity.Review
+	var totalRecord int64
+	err := service.TxManager.ReadOnly(ctx, func(ctx context.Context) error {
+		var err error
+		reviews, totalRecord, err = service.ReviewRepository.FindByField(ctx, fieldId, limit, offset)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalPage := (totalRecord + int64(limit) - 1) / int64(limit)
+
+	return reviews, &dto.PaginationResponse{
+		CurrentPage: page,
+		Limit:       limit,
+		TotalRecord: uint32(totalRecord),
+		TotalPage:   uint32(totalPage),
+	}, nil
+}
@@ -0,0 +1,319 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/helper"
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+)
+
+// MaxGenerateWeeks bounds how far ahead Generate materializes slots, every
+// field being generated in a single transaction.
+const MaxGenerateWeeks uint32 = 26
+
+type ScheduleTemplateUseCase interface {
+	Save(ctx context.Context, caller *dto.Caller, request *dto.ScheduleTemplateRequest) (*entity.ScheduleTemplate, error)
+	Delete(ctx context.Context, caller *dto.Caller, templateId uint) error
+	FindByField(ctx context.Context, fieldId uint) (*[]entity.ScheduleTemplate, error)
+	Generate(ctx context.Context, weeks uint32) error
+}
+
+type ScheduleTemplateUseCaseImpl struct {
+	ScheduleTemplateRepository repository.ScheduleTemplateRepository
+	ScheduleRepository         repository.ScheduleRepository
+	FieldRepository            repository.FieldRepository
+	BlackoutRepository         repository.BlackoutRepository
+	TxManager                  txmanager.Manager
+	validate                   *validator.Validate
+}
+
+func NewScheduleTemplateUseCase(scheduleTemplateRepository repository.ScheduleTemplateRepository, scheduleRepository repository.ScheduleRepository, fieldRepository repository.FieldRepository, blackoutRepository repository.BlackoutRepository, txManager txmanager.Manager, validate *validator.Validate) ScheduleTemplateUseCase {
+	return &ScheduleTemplateUseCaseImpl{
+		ScheduleTemplateRepository: scheduleTemplateRepository,
+		ScheduleRepository:         scheduleRepository,
+		FieldRepository:            fieldRepository,
+		BlackoutRepository:         blackoutRepository,
+		TxManager:                  txManager,
+		validate:                   validate,
+	}
+}
+
+// Save implements ScheduleTemplateUseCase
+func (service *ScheduleTemplateUseCaseImpl) Save(ctx context.Context, caller *dto.Caller, request *dto.ScheduleTemplateRequest) (*entity.ScheduleTemplate, error) {
+
+	if err := service.validate.Struct(request); err != nil {
+		return nil, err
+	}
+
+	openTime, _ := time.Parse("15:04", request.OpenTime)
+	closeTime, _ := time.Parse("15:04", request.CloseTime)
+	if closeTime.Sub(openTime) < time.Duration(request.SlotDuration)*time.Minute {
+		return nil, apperror.Violation("CloseTime", "close time must be at least one slot after open time")
+	}
+
+	var response *entity.ScheduleTemplate
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		field, err := service.FieldRepository.FindById(ctx, request.FieldId)
+		if err != nil {
+			return err
+		}
+
+		if !canMutateField(caller, field) {
+			return helper.ErrPermissionDenied
+		}
+
+		//every generated slot has to be a booking the field accepts
+		duration := request.SlotDuration
+		if duration < field.MinDuration || (field.MaxDuration > 0 && duration > field.MaxDuration) {
+			return apperror.InvalidArgument("slot duration must be between %d and %d minutes", field.MinDuration, field.MaxDuration)
+		}
+		if field.Granularity > 0 && (duration%field.Granularity != 0 || uint32(openTime.Hour()*60+openTime.Minute())%field.Granularity != 0) {
+			return apperror.InvalidArgument("open time and slot duration must be multiples of %d minutes", field.Granularity)
+		}
+
+		templateData := entity.ScheduleTemplate{
+			FieldId:      request.FieldId,
+			Weekday:      time.Weekday(request.Weekday),
+			OpenTime:     request.OpenTime,
+			CloseTime:    request.CloseTime,
+			SlotDuration: request.SlotDuration,
+		}
+
+		response, err = service.ScheduleTemplateRepository.Save(ctx, &templateData)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Delete implements ScheduleTemplateUseCase
+func (service *ScheduleTemplateUseCaseImpl) Delete(ctx context.Context, caller *dto.Caller, templateId uint) error {
+
+	return service.TxManager.Do(ctx, func(ctx context.Context) error {
+		template, err := service.ScheduleTemplateRepository.FindById(ctx, templateId)
+		if err != nil {
+			return err
+		}
+
+		field, err := service.FieldRepository.FindById(ctx, template.FieldId)
+		if err != nil {
+			return err
+		}
+
+		if !canMutateField(caller, field) {
+			return helper.ErrPermissionDenied
+		}
+
+		if err := service.ScheduleTemplateRepository.Delete(ctx, templateId); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// FindByField implements ScheduleTemplateUseCase
+func (service *ScheduleTemplateUseCaseImpl) FindByField(ctx context.Context, fieldId uint) (*[]entity.ScheduleTemplate, error) {
+
+	templates, err := service.ScheduleTemplateRepository.FindByField(ctx, fieldId)
+	if err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// Generate implements ScheduleTemplateUseCase. Missing slots are created and
+// future available generated slots that no longer match a template, or that
+// overlap a blackout window, are removed, also on fields whose templates are
+// all gone; slots made by hand, reserved and sold slots are never touched, so
+// running it repeatedly is safe. A slot overlapping a schedule that is kept,
+// e.g. a longer booking made by hand, is not created. Slots follow the wall clock of the field time
+// zone, so a template keeps opening at the same local hour across DST changes.
+func (service *ScheduleTemplateUseCaseImpl) Generate(ctx context.Context, weeks uint32) error {
+
+	if weeks < 1 {
+		return apperror.Violation("Weeks", "weeks must be greater than zero")
+	}
+	if weeks > MaxGenerateWeeks {
+		return apperror.Violation("Weeks", fmt.Sprintf("weeks must not exceed %d", MaxGenerateWeeks))
+	}
+
+	templates, err := service.ScheduleTemplateRepository.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	templatesByField := make(map[uint][]entity.ScheduleTemplate)
+	for _, t := range *templates {
+		templatesByField[t.FieldId] = append(templatesByField[t.FieldId], t)
+	}
+
+	now := time.Now()
+
+	//fields left with generated slots but no template are emptied
+	generatedFieldIds, err := service.ScheduleRepository.FindGeneratedFieldIds(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, fieldId := range generatedFieldIds {
+		if _, ok := templatesByField[fieldId]; !ok {
+			templatesByField[fieldId] = nil
+		}
+	}
+
+	//each field is generated in its own transaction, a failing field does not
+	//hold back the others
+	var errs []error
+	for fieldId, fieldTemplates := range templatesByField {
+		err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+			return service.generateField(ctx, fieldId, fieldTemplates, now, 7*int(weeks))
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("field %d: %w", fieldId, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (service *ScheduleTemplateUseCaseImpl) generateField(ctx context.Context, fieldId uint, templates []entity.ScheduleTemplate, now time.Time, days int) error {
+
+	field, err := service.FieldRepository.FindById(ctx, fieldId)
+	if err != nil {
+		return err
+	}
+
+	//days are counted in the field time zone, a day is not always 24 hours long
+	loc := field.Location()
+	local := now.In(loc)
+	start := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	end := time.Date(local.Year(), local.Month(), local.Day()+days, 0, 0, 0, 0, loc)
+
+	blackouts, err := service.BlackoutRepository.FindByField(ctx, fieldId, start, end)
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[int64]entity.Schedule)
+	for i := 0; i < days; i++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+i, 0, 0, 0, 0, loc)
+		for _, t := range templates {
+			if t.Weekday != day.Weekday() {
+				continue
+			}
+			duration := time.Duration(t.SlotDuration) * time.Minute
+			for _, slot := range templateSlots(&t, day) {
+				if slot.After(now) && !overlapsBlackout(*blackouts, slot, slot.Add(duration)) {
+					wanted[slot.Unix()] = entity.Schedule{
+						FieldId:   fieldId,
+						Date:      slot.UTC(),
+						EndDate:   slot.Add(duration).UTC(),
+						Status:    entity.ScheduleStatusAvailable,
+						Generated: true,
+					}
+				}
+			}
+		}
+	}
+
+	existing, err := service.ScheduleRepository.FindByField(ctx, fieldId, start, end)
+	if err != nil {
+		return err
+	}
+
+	existingIds := make([]uint, 0, len(*existing))
+	for _, s := range *existing {
+		existingIds = append(existingIds, s.Id)
+	}
+	referencedIds, err := service.ScheduleRepository.FindReferencedIds(ctx, existingIds)
+	if err != nil {
+		return err
+	}
+	//slots a transaction or a review refers to are history and stay
+	referenced := make(map[uint]bool, len(referencedIds))
+	for _, id := range referencedIds {
+		referenced[id] = true
+	}
+
+	kept := make([]entity.Schedule, 0, len(*existing))
+	for _, s := range *existing {
+		if w, ok := wanted[s.Date.Unix()]; ok && w.EndDate.Equal(s.EndDate) {
+			delete(wanted, s.Date.Unix())
+			kept = append(kept, s)
+			continue
+		}
+		if s.Generated && s.Status == entity.ScheduleStatusAvailable && s.Date.After(now) && !referenced[s.Id] {
+			if err := service.ScheduleRepository.Delete(ctx, s.Id); err != nil {
+				return err
+			}
+			continue
+		}
+		kept = append(kept, s)
+	}
+
+	slots := make([]entity.Schedule, 0, len(wanted))
+	for _, slot := range wanted {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool { return slots[i].Date.Before(slots[j].Date) })
+
+	//templates of the same day may overlap each other, the earlier slot wins
+	for _, slot := range slots {
+		if overlapsSchedule(kept, slot.Date, slot.EndDate) {
+			continue
+		}
+		if _, err := service.ScheduleRepository.Save(ctx, &slot); err != nil {
+			return err
+		}
+		kept = append(kept, slot)
+	}
+
+	return nil
+}
+
+// templateSlots returns the start time of every slot the template opens on day,
+// laid out on the wall clock of day's location. A slot starting in the hour
+// skipped when DST begins does not exist that day and is left out; when DST
+// ends the repeated hour still opens a single slot.
+func templateSlots(template *entity.ScheduleTemplate, day time.Time) []time.Time {
+	openTime, err := time.Parse("15:04", template.OpenTime)
+	if err != nil {
+		return nil
+	}
+	closeTime, err := time.Parse("15:04", template.CloseTime)
+	if err != nil {
+		return nil
+	}
+
+	duration := int(template.SlotDuration)
+	if duration <= 0 {
+		return nil
+	}
+
+	open := openTime.Hour()*60 + openTime.Minute()
+	closing := closeTime.Hour()*60 + closeTime.Minute()
+
+	var slots []time.Time
+	for minute := open; minute+duration <= closing; minute += duration {
+		slot := time.Date(day.Year(), day.Month(), day.Day(), 0, minute, 0, 0, day.Location())
+		if slot.Hour()*60+slot.Minute() != minute {
+			continue
+		}
+		slots = append(slots, slot)
+	}
+
+	return slots
+}
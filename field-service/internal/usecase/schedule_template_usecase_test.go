@@ -0,0 +1,248 @@
+//go:build cgo
+
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+	_ "time/tzdata" //the DST cases must not depend on the host zone database
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+)
+
+// dstZone springs forward on 2025-03-09, skipping 02:00-03:00, and falls
+// back on 2025-11-02, repeating 01:00-02:00.
+const dstZone = "America/New_York"
+
+func utcTimes(t *testing.T, values ...string) []time.Time {
+	t.Helper()
+
+	times := make([]time.Time, 0, len(values))
+	for _, value := range values {
+		v, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		times = append(times, v)
+	}
+	return times
+}
+
+func TestTemplateSlots(t *testing.T) {
+	loc := entity.LoadLocation(dstZone)
+
+	tests := []struct {
+		name     string
+		template entity.ScheduleTemplate
+		day      time.Time
+		want     []string
+	}{
+		{
+			name:     "standard day",
+			template: entity.ScheduleTemplate{OpenTime: "00:00", CloseTime: "05:00", SlotDuration: 60},
+			day:      time.Date(2025, 3, 2, 0, 0, 0, 0, loc),
+			want: []string{
+				"2025-03-02T05:00:00Z",
+				"2025-03-02T06:00:00Z",
+				"2025-03-02T07:00:00Z",
+				"2025-03-02T08:00:00Z",
+				"2025-03-02T09:00:00Z",
+			},
+		},
+		{
+			name:     "spring forward skips the missing hour",
+			template: entity.ScheduleTemplate{OpenTime: "00:00", CloseTime: "05:00", SlotDuration: 60},
+			day:      time.Date(2025, 3, 9, 0, 0, 0, 0, loc),
+			want: []string{
+				"2025-03-09T05:00:00Z",
+				"2025-03-09T06:00:00Z",
+				"2025-03-09T07:00:00Z",
+				"2025-03-09T08:00:00Z",
+			},
+		},
+		{
+			name:     "spring forward with slots inside the missing hour",
+			template: entity.ScheduleTemplate{OpenTime: "01:00", CloseTime: "04:00", SlotDuration: 30},
+			day:      time.Date(2025, 3, 9, 0, 0, 0, 0, loc),
+			want: []string{
+				"2025-03-09T06:00:00Z",
+				"2025-03-09T06:30:00Z",
+				"2025-03-09T07:00:00Z",
+				"2025-03-09T07:30:00Z",
+			},
+		},
+		{
+			name:     "fall back opens the repeated hour once",
+			template: entity.ScheduleTemplate{OpenTime: "00:00", CloseTime: "05:00", SlotDuration: 60},
+			day:      time.Date(2025, 11, 2, 0, 0, 0, 0, loc),
+			want: []string{
+				"2025-11-02T04:00:00Z",
+				"2025-11-02T05:00:00Z",
+				"2025-11-02T07:00:00Z",
+				"2025-11-02T08:00:00Z",
+				"2025-11-02T09:00:00Z",
+			},
+		},
+		{
+			name:     "slot longer than opening hours",
+			template: entity.ScheduleTemplate{OpenTime: "08:00", CloseTime: "09:00", SlotDuration: 90},
+			day:      time.Date(2025, 3, 9, 0, 0, 0, 0, loc),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := templateSlots(&tt.template, tt.day)
+			want := utcTimes(t, tt.want...)
+
+			if len(got) != len(want) {
+				t.Fatalf("got %d slots %v, want %d", len(got), got, len(want))
+			}
+			for i := range want {
+				if !got[i].Equal(want[i]) {
+					t.Errorf("slot %d = %s, want %s", i, got[i].UTC().Format(time.RFC3339), want[i].Format(time.RFC3339))
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateFieldAcrossDST(t *testing.T) {
+	loc := entity.LoadLocation(dstZone)
+
+	templates := []entity.ScheduleTemplate{{
+		FieldId:      1,
+		Weekday:      time.Sunday,
+		OpenTime:     "00:00",
+		CloseTime:    "05:00",
+		SlotDuration: 60,
+	}}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want []string
+	}{
+		{
+			name: "spring forward",
+			now:  time.Date(2025, 3, 8, 12, 0, 0, 0, loc),
+			want: []string{
+				"2025-03-09T05:00:00Z",
+				"2025-03-09T06:00:00Z",
+				"2025-03-09T07:00:00Z",
+				"2025-03-09T08:00:00Z",
+			},
+		},
+		{
+			name: "fall back",
+			now:  time.Date(2025, 11, 1, 12, 0, 0, 0, loc),
+			want: []string{
+				"2025-11-02T04:00:00Z",
+				"2025-11-02T05:00:00Z",
+				"2025-11-02T07:00:00Z",
+				"2025-11-02T08:00:00Z",
+				"2025-11-02T09:00:00Z",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newScheduleTestDB(t)
+			if err := db.Exec("INSERT INTO fields (id, timezone) VALUES (1, ?)", dstZone).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			scheduleRepo := repository.NewScheduleRepository(db)
+			service := &ScheduleTemplateUseCaseImpl{
+				ScheduleRepository: scheduleRepo,
+				FieldRepository:    repository.NewFieldRepository(db),
+				BlackoutRepository: repository.NewBlackoutRepository(db),
+			}
+
+			ctx := context.Background()
+			want := utcTimes(t, tt.want...)
+
+			//a second run over the same days must keep the slots as they are
+			for run := 1; run <= 2; run++ {
+				if err := service.generateField(ctx, 1, templates, tt.now, 2); err != nil {
+					t.Fatalf("run %d: %v", run, err)
+				}
+
+				var schedules []entity.Schedule
+				if err := db.Order("date ASC").Find(&schedules).Error; err != nil {
+					t.Fatal(err)
+				}
+				if len(schedules) != len(want) {
+					t.Fatalf("run %d: got %d schedules, want %d", run, len(schedules), len(want))
+				}
+				for i, s := range schedules {
+					if !s.Date.Equal(want[i]) || !s.EndDate.Equal(want[i].Add(time.Hour)) {
+						t.Errorf("run %d: schedule %d = [%s, %s), want [%s, %s)", run, i,
+							s.Date.UTC().Format(time.RFC3339), s.EndDate.UTC().Format(time.RFC3339),
+							want[i].Format(time.RFC3339), want[i].Add(time.Hour).Format(time.RFC3339))
+					}
+					if !s.Generated || s.Status != entity.ScheduleStatusAvailable {
+						t.Errorf("run %d: schedule %d is %s, generated %t", run, i, s.Status, s.Generated)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateSkipsDeletedFields(t *testing.T) {
+	db := newScheduleTestDB(t)
+	ctx := context.Background()
+
+	deletedAt := time.Now().Add(-time.Hour)
+	if err := db.Exec("INSERT INTO fields (id, deleted_at) VALUES (1, ?), (2, NULL)", deletedAt).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	//the deleted field still has a generated slot ahead and its templates
+	scheduleRepo := repository.NewScheduleRepository(db)
+	date := time.Now().Add(48 * time.Hour)
+	leftover := entity.Schedule{FieldId: 1, Date: date, EndDate: date.Add(time.Hour), Status: entity.ScheduleStatusAvailable, Generated: true}
+	if _, err := scheduleRepo.Save(ctx, &leftover); err != nil {
+		t.Fatal(err)
+	}
+
+	templateRepo := repository.NewScheduleTemplateRepository(db)
+	for _, fieldId := range []uint{1, 2} {
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			template := entity.ScheduleTemplate{FieldId: fieldId, Weekday: day, OpenTime: "08:00", CloseTime: "10:00", SlotDuration: 60}
+			if _, err := templateRepo.Save(ctx, &template); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	service := NewScheduleTemplateUseCase(templateRepo, scheduleRepo, repository.NewFieldRepository(db), repository.NewBlackoutRepository(db), txmanager.NewManager(db), validator.New())
+
+	if err := service.Generate(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	count := func(fieldId uint) int64 {
+		t.Helper()
+
+		var n int64
+		if err := db.Table("schedules").Where("field_id = ?", fieldId).Count(&n).Error; err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	//six full days ahead and today's slots if still to come
+	if n := count(2); n < 12 {
+		t.Errorf("field 2 has %d slots, want at least 12", n)
+	}
+	if n := count(1); n != 1 {
+		t.Errorf("deleted field 1 has %d slots, want its 1 leftover untouched", n)
+	}
+}
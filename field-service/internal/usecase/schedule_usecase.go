@@ -0,0 +1,550 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/broadcast"
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/helper"
+	"github.com/DevisArya/learn-microservices/field-service/internal/outbox"
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	outboxkit "github.com/DevisArya/learn-microservices/svckit/outbox"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+)
+
+type ScheduleUseCase interface {
+	Save(ctx context.Context, caller *dto.Caller, request *dto.ScheduleRequest) (*entity.Schedule, error)
+	UpdateStatus(ctx context.Context, caller *dto.Caller, request *dto.ScheduleStatusRequest, id uint) error
+	Delete(ctx context.Context, caller *dto.Caller, scheduleId uint) error
+	FindById(ctx context.Context, scheduleId uint) (*entity.Schedule, error)
+	FindByField(ctx context.Context, filter *dto.ScheduleFilter) (*[]entity.Schedule, error)
+	Location(ctx context.Context, fieldId uint) (*time.Location, error)
+	ExtendHold(ctx context.Context, caller *dto.Caller, scheduleId uint) (*time.Time, error)
+	ReleaseExpiredHolds(ctx context.Context) (int64, error)
+	Watch(filter *dto.ScheduleFilter, resumeToken string) (*broadcast.ScheduleSubscription, error)
+	Unwatch(subscription *broadcast.ScheduleSubscription)
+	ResumeToken(event *dto.ScheduleEvent) string
+}
+
+type ScheduleUseCaseImpl struct {
+	ScheduleRepository    repository.ScheduleRepository
+	FieldRepository       repository.FieldRepository
+	BlackoutRepository    repository.BlackoutRepository
+	OutboxRepository      outboxkit.Repository
+	TransactionRepository repository.TransactionRepository
+	Broadcaster           broadcast.ScheduleBroadcaster
+	TxManager             txmanager.Manager
+	validate              *validator.Validate
+
+	// HoldDuration is how long a reserved slot is held for its user before the
+	// sweeper returns it to available. Zero keeps holds until changed by hand.
+	HoldDuration time.Duration
+}
+
+func NewScheduleUseCase(scheduleRepository repository.ScheduleRepository, fieldRepository repository.FieldRepository, blackoutRepository repository.BlackoutRepository, outboxRepository outboxkit.Repository, transactionRepository repository.TransactionRepository, broadcaster broadcast.ScheduleBroadcaster, txManager txmanager.Manager, validate *validator.Validate, holdDuration time.Duration) ScheduleUseCase {
+	return &ScheduleUseCaseImpl{
+		ScheduleRepository:    scheduleRepository,
+		FieldRepository:       fieldRepository,
+		BlackoutRepository:    blackoutRepository,
+		OutboxRepository:      outboxRepository,
+		TransactionRepository: transactionRepository,
+		Broadcaster:           broadcaster,
+		TxManager:             txManager,
+		validate:              validate,
+		HoldDuration:          holdDuration,
+	}
+}
+
+// Save implements ScheduleUseCase. The schedule must fit the duration bounds
+// and granularity of its field and may not overlap another of its schedules;
+// the field row is locked so concurrent inserts can not both pass the check.
+// Only the operator owning the field or a super user may add slots.
+func (service *ScheduleUseCaseImpl) Save(ctx context.Context, caller *dto.Caller, request *dto.ScheduleRequest) (*entity.Schedule, error) {
+
+	if err := service.validate.Struct(request); err != nil {
+		return nil, err
+	}
+
+	var response *entity.Schedule
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		field, err := service.FieldRepository.LockById(ctx, request.FieldId)
+		if err != nil {
+			return err
+		}
+
+		if !canMutateField(caller, field) {
+			return helper.ErrPermissionDenied
+		}
+
+		if err := field.CheckBooking(request.Date, request.EndDate); err != nil {
+			return err
+		}
+
+		if err := checkBlackout(ctx, service.BlackoutRepository, request.FieldId, request.Date, request.EndDate); err != nil {
+			return err
+		}
+
+		overlapping, err := service.ScheduleRepository.FindByField(ctx, request.FieldId, request.Date, request.EndDate)
+		if err != nil {
+			return err
+		}
+		if len(*overlapping) > 0 {
+			return helper.ErrScheduleOverlap
+		}
+
+		scheduleData := entity.Schedule{
+			FieldId: request.FieldId,
+			Date:    request.Date.UTC(),
+			EndDate: request.EndDate.UTC(),
+			Status:  entity.ScheduleStatusAvailable,
+		}
+
+		response, err = service.ScheduleRepository.Save(ctx, &scheduleData)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// UpdateStatus implements ScheduleUseCase. Concurrent transitions of the same
+// slot are settled by the version compare-and-swap in the repository: the
+// loser gets helper.ErrConcurrentUpdate and nothing is written. Callers book
+// and release slots for themselves; only the operator owning the field or a
+// super user may act for another user or release a slot someone else holds.
+func (service *ScheduleUseCaseImpl) UpdateStatus(ctx context.Context, caller *dto.Caller, request *dto.ScheduleStatusRequest, id uint) error {
+
+	if err := service.validate.Struct(request); err != nil {
+		return err
+	}
+
+	var event *dto.ScheduleEvent
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		event, err = service.updateStatus(ctx, caller, request, id)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	//watchers only hear about committed changes
+	service.Broadcaster.Publish(*event)
+
+	return nil
+}
+
+func (service *ScheduleUseCaseImpl) updateStatus(ctx context.Context, caller *dto.Caller, request *dto.ScheduleStatusRequest, id uint) (*dto.ScheduleEvent, error) {
+
+	status := entity.ScheduleStatus(request.Status)
+
+	schedule, err := service.ScheduleRepository.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	field, err := service.FieldRepository.FindById(ctx, schedule.FieldId)
+	if err != nil {
+		return nil, err
+	}
+	manager := canMutateField(caller, field)
+
+	//reserved and sold slot belong to the caller unless the field manager books
+	//it for someone else, available slot belongs to no one
+	userId := &caller.UserId
+	if request.UserId != nil && *request.UserId != caller.UserId {
+		if !manager {
+			return nil, helper.ErrPermissionDenied
+		}
+		userId = request.UserId
+	}
+	if status == entity.ScheduleStatusAvailable {
+		userId = nil
+	}
+
+	//slot of another user can only be released by the field manager
+	if status == entity.ScheduleStatusAvailable && schedule.UserId != nil && *schedule.UserId != caller.UserId && !manager {
+		return nil, helper.ErrPermissionDenied
+	}
+
+	if schedule.Status == entity.ScheduleStatusSold && status != entity.ScheduleStatusSold {
+		return nil, apperror.FailedPrecondition("sold schedule can not be changed")
+	}
+
+	//slot held or sold by another user can not be taken over
+	if status != entity.ScheduleStatusAvailable && scheduleTaken(schedule, *userId, time.Now()) {
+		return nil, helper.ErrScheduleTaken
+	}
+
+	//slot inside a blackout window can not be booked
+	if status != entity.ScheduleStatusAvailable && schedule.Status != entity.ScheduleStatusSold {
+		if err := checkBlackout(ctx, service.BlackoutRepository, schedule.FieldId, schedule.Date, schedule.EndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	//booking a part of a divisible field blocks its parent and the other way round
+	if status != entity.ScheduleStatusAvailable {
+		if err := checkRelatedBooked(ctx, service.FieldRepository, service.ScheduleRepository, schedule, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	//reserved slot is only held until its expiry
+	var holdExpiresAt *time.Time
+	if status == entity.ScheduleStatusReserved && service.HoldDuration > 0 {
+		expiresAt := time.Now().Add(service.HoldDuration)
+		holdExpiresAt = &expiresAt
+	}
+
+	updated, err := service.ScheduleRepository.UpdateStatus(ctx, id, schedule.Version, status, userId, holdExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if updated == 0 {
+		return nil, helper.ErrConcurrentUpdate
+	}
+
+	event := dto.ScheduleEvent{
+		Schedule:       *schedule,
+		PreviousStatus: schedule.Status,
+		OccurredAt:     time.Now(),
+	}
+	event.Schedule.Status = status
+	event.Schedule.UserId = userId
+	event.Schedule.HoldExpiresAt = holdExpiresAt
+	event.Schedule.Version++
+
+	if status == entity.ScheduleStatusReserved {
+		if err := scheduleReserved(ctx, service.OutboxRepository, &event.Schedule); err != nil {
+			return nil, err
+		}
+	}
+
+	return &event, nil
+}
+
+// scheduleReserved records in the outbox that schedule was reserved.
+func scheduleReserved(ctx context.Context, outboxRepository outboxkit.Repository, schedule *entity.Schedule) error {
+	event, err := outbox.NewScheduleReserved(schedule)
+	if err != nil {
+		return err
+	}
+
+	_, err = outboxRepository.Save(ctx, event)
+	return err
+}
+
+// Delete implements ScheduleUseCase. Only the operator owning the field or a
+// super user may delete its slots.
+func (service *ScheduleUseCaseImpl) Delete(ctx context.Context, caller *dto.Caller, scheduleId uint) error {
+
+	return service.TxManager.Do(ctx, func(ctx context.Context) error {
+		schedule, err := service.ScheduleRepository.FindById(ctx, scheduleId)
+		if err != nil {
+			return err
+		}
+
+		field, err := service.FieldRepository.FindById(ctx, schedule.FieldId)
+		if err != nil {
+			return err
+		}
+
+		if !canMutateField(caller, field) {
+			return helper.ErrPermissionDenied
+		}
+
+		if schedule.Status != entity.ScheduleStatusAvailable {
+			return apperror.FailedPrecondition("only available schedule can be deleted")
+		}
+
+		referenced, err := service.ScheduleRepository.FindReferencedIds(ctx, []uint{scheduleId})
+		if err != nil {
+			return err
+		}
+		if len(referenced) > 0 {
+			return apperror.FailedPrecondition("schedule referenced by a transaction or a review can not be deleted")
+		}
+
+		if err := service.ScheduleRepository.Delete(ctx, scheduleId); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// FindById implements ScheduleUseCase
+func (service *ScheduleUseCaseImpl) FindById(ctx context.Context, scheduleId uint) (*entity.Schedule, error) {
+
+	schedule, err := service.ScheduleRepository.FindById(ctx, scheduleId)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// Location implements ScheduleUseCase. It returns the time zone schedules of
+// fieldId are presented in.
+func (service *ScheduleUseCaseImpl) Location(ctx context.Context, fieldId uint) (*time.Location, error) {
+
+	field, err := service.FieldRepository.FindById(ctx, fieldId)
+	if err != nil {
+		return nil, err
+	}
+
+	return field.Location(), nil
+}
+
+// FindByField implements ScheduleUseCase. Available slots inside a blackout
+// window or overlapping a booked part (or parent) of a divisible field are left
+// out since they can not be booked; reserved and sold ones are still listed.
+func (service *ScheduleUseCaseImpl) FindByField(ctx context.Context, filter *dto.ScheduleFilter) (*[]entity.Schedule, error) {
+
+	if err := service.validate.Struct(filter); err != nil {
+		return nil, err
+	}
+
+	var schedules *[]entity.Schedule
+	var blackouts *[]entity.Blackout
+	var related *[]entity.Schedule
+	err := service.TxManager.ReadOnly(ctx, func(ctx context.Context) error {
+		var err error
+		schedules, err = service.ScheduleRepository.FindByField(ctx, filter.FieldId, filter.StartDate, filter.EndDate)
+		if err != nil {
+			return err
+		}
+
+		blackouts, err = service.BlackoutRepository.FindByField(ctx, filter.FieldId, filter.StartDate, filter.EndDate)
+		if err != nil {
+			return err
+		}
+
+		related, err = service.ScheduleRepository.FindRelatedBookings(ctx, filter.FieldId, filter.StartDate, filter.EndDate)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	blocked := make([]entity.Schedule, 0, len(*related))
+	for _, r := range *related {
+		if bookingActive(&r, now) {
+			blocked = append(blocked, r)
+		}
+	}
+
+	if len(*blackouts) == 0 && len(blocked) == 0 {
+		return schedules, nil
+	}
+
+	open := make([]entity.Schedule, 0, len(*schedules))
+	for _, s := range *schedules {
+		if s.Status == entity.ScheduleStatusAvailable && (overlapsBlackout(*blackouts, s.Date, s.EndDate) || overlapsSchedule(blocked, s.Date, s.EndDate)) {
+			continue
+		}
+		open = append(open, s)
+	}
+
+	return &open, nil
+}
+
+// ExtendHold implements ScheduleUseCase. Only the user holding the slot may
+// extend it, and only while the hold is still active; the new expiry is a full
+// HoldDuration from now.
+func (service *ScheduleUseCaseImpl) ExtendHold(ctx context.Context, caller *dto.Caller, scheduleId uint) (*time.Time, error) {
+
+	if service.HoldDuration <= 0 {
+		return nil, apperror.FailedPrecondition("schedule holds do not expire")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(service.HoldDuration)
+
+	extended, err := service.ScheduleRepository.ExtendHold(ctx, scheduleId, caller.UserId, now, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if extended == 0 {
+		schedule, err := service.ScheduleRepository.FindById(ctx, scheduleId)
+		if err != nil {
+			return nil, err
+		}
+		if schedule.UserId == nil || *schedule.UserId != caller.UserId {
+			return nil, helper.ErrPermissionDenied
+		}
+		return nil, helper.ErrHoldExpired
+	}
+
+	return &expiresAt, nil
+}
+
+// ReleaseExpiredHolds implements ScheduleUseCase. It returns every reserved
+// slot whose hold expired to available. Each release is a compare-and-swap on
+// the slot version, so replicas sweeping at the same time release a hold once
+// and never touch one that was extended or sold in the meantime. The unpaid
+// transaction booking a released slot is expired in the same transaction, so
+// a slot is never referenced by two live transactions.
+func (service *ScheduleUseCaseImpl) ReleaseExpiredHolds(ctx context.Context) (int64, error) {
+
+	var events []dto.ScheduleEvent
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		events, err = service.releaseExpiredHolds(ctx)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, event := range events {
+		service.Broadcaster.Publish(event)
+	}
+
+	return int64(len(events)), nil
+}
+
+func (service *ScheduleUseCaseImpl) releaseExpiredHolds(ctx context.Context) ([]dto.ScheduleEvent, error) {
+
+	now := time.Now()
+
+	expired, err := service.ScheduleRepository.FindExpiredHolds(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]dto.ScheduleEvent, 0, len(*expired))
+	for _, s := range *expired {
+		updated, err := service.ScheduleRepository.UpdateStatus(ctx, s.Id, s.Version, entity.ScheduleStatusAvailable, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		//another replica or the holder got there first
+		if updated == 0 {
+			continue
+		}
+
+		if _, err := service.TransactionRepository.ExpireUnpaidBySchedule(ctx, s.Id); err != nil {
+			return nil, err
+		}
+
+		event := dto.ScheduleEvent{
+			Schedule:       s,
+			PreviousStatus: s.Status,
+			OccurredAt:     now,
+		}
+		event.Schedule.Status = entity.ScheduleStatusAvailable
+		event.Schedule.UserId = nil
+		event.Schedule.HoldExpiresAt = nil
+		event.Schedule.Version++
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// Watch implements ScheduleUseCase
+func (service *ScheduleUseCaseImpl) Watch(filter *dto.ScheduleFilter, resumeToken string) (*broadcast.ScheduleSubscription, error) {
+
+	if err := service.validate.Struct(filter); err != nil {
+		return nil, err
+	}
+
+	return service.Broadcaster.Subscribe(filter, resumeToken)
+}
+
+// Unwatch implements ScheduleUseCase
+func (service *ScheduleUseCaseImpl) Unwatch(subscription *broadcast.ScheduleSubscription) {
+	service.Broadcaster.Unsubscribe(subscription)
+}
+
+// ResumeToken implements ScheduleUseCase
+func (service *ScheduleUseCaseImpl) ResumeToken(event *dto.ScheduleEvent) string {
+	return service.Broadcaster.ResumeToken(event)
+}
+
+// scheduleTaken reports whether schedule is reserved or sold by someone other
+// than userId. A reservation whose hold already expired no longer counts, even
+// if the sweeper has not released it yet.
+func scheduleTaken(schedule *entity.Schedule, userId uint, now time.Time) bool {
+	if !bookingActive(schedule, now) {
+		return false
+	}
+
+	return schedule.UserId == nil || *schedule.UserId != userId
+}
+
+// bookingActive reports whether schedule is sold or held by an unexpired hold.
+func bookingActive(schedule *entity.Schedule, now time.Time) bool {
+	switch schedule.Status {
+	case entity.ScheduleStatusSold:
+		return true
+	case entity.ScheduleStatusReserved:
+		return schedule.HoldExpiresAt == nil || schedule.HoldExpiresAt.After(now)
+	default:
+		return false
+	}
+}
+
+// checkBlackout returns helper.ErrBlackedOut when [start, end) of the field
+// overlaps a blackout window.
+func checkBlackout(ctx context.Context, blackoutRepository repository.BlackoutRepository, fieldId uint, start time.Time, end time.Time) error {
+
+	blackouts, err := blackoutRepository.FindByField(ctx, fieldId, start, end)
+	if err != nil {
+		return err
+	}
+
+	if len(*blackouts) > 0 {
+		return helper.ErrBlackedOut
+	}
+
+	return nil
+}
+
+// checkRelatedBooked returns helper.ErrFieldPartBooked when the parent or a
+// part of the schedule's field is booked at an overlapping time. The head of
+// the field family is locked before the related schedules, so bookings of a
+// parent and of its parts take their locks in the same order; all stay locked
+// until the transaction of ctx ends.
+func checkRelatedBooked(ctx context.Context, fieldRepository repository.FieldRepository, scheduleRepository repository.ScheduleRepository, schedule *entity.Schedule, now time.Time) error {
+
+	if err := fieldRepository.LockFamilies(ctx, []uint{schedule.FieldId}); err != nil {
+		return err
+	}
+
+	related, err := scheduleRepository.LockRelated(ctx, schedule.FieldId, schedule.Date, schedule.EndDate)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range *related {
+		if r.FieldId != schedule.FieldId && bookingActive(&r, now) {
+			return helper.ErrFieldPartBooked
+		}
+	}
+
+	return nil
+}
+
+// overlapsSchedule reports whether [start, end) intersects any of schedules.
+func overlapsSchedule(schedules []entity.Schedule, start time.Time, end time.Time) bool {
+	for _, s := range schedules {
+		if s.Overlaps(start, end) {
+			return true
+		}
+	}
+
+	return false
+}
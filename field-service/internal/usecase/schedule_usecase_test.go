@@ -0,0 +1,550 @@
+//go:build cgo
+
+// The use case tests run the real repositories against sqlite: the guarantees
+// under test, such as the version compare-and-swap, live in the SQL they
+// issue, which a fake repository would not exercise. The sqlite driver needs
+// cgo and is only linked into test binaries.
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/broadcast"
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/helper"
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	outboxkit "github.com/DevisArya/learn-microservices/svckit/outbox"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newScheduleTestDB opens a throwaway sqlite database with the tables the
+// schedule, transaction and field use cases touch.
+func newScheduleTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?_busy_timeout=10000", filepath.Join(t.TempDir(), "schedule.db"))
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, stmt := range []string{
+		`CREATE TABLE schedules (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			user_id INTEGER NULL,
+			field_id INTEGER NOT NULL,
+			date DATETIME NOT NULL,
+			end_date DATETIME NOT NULL,
+			status TEXT NOT NULL,
+			hold_expires_at DATETIME NULL,
+			version INTEGER NOT NULL DEFAULT 0,
+			generated BOOLEAN NOT NULL DEFAULT FALSE
+		)`,
+		`CREATE TABLE venues (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL DEFAULT '',
+			address TEXT NOT NULL DEFAULT '',
+			latitude REAL NOT NULL DEFAULT 0,
+			longitude REAL NOT NULL DEFAULT 0,
+			timezone TEXT NOT NULL DEFAULT 'UTC'
+		)`,
+		`CREATE TABLE fields (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			venue_id INTEGER NULL REFERENCES venues (id) ON DELETE SET NULL,
+			parent_id INTEGER NULL,
+			operator_id INTEGER NULL,
+			name TEXT NOT NULL DEFAULT '',
+			type TEXT NOT NULL DEFAULT '',
+			description TEXT NOT NULL DEFAULT '',
+			price INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME NULL,
+			timezone TEXT NOT NULL DEFAULT 'UTC',
+			deleted_at DATETIME NULL
+		)`,
+		`CREATE TABLE schedule_templates (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			field_id INTEGER NOT NULL,
+			weekday INTEGER NOT NULL,
+			open_time TEXT NOT NULL,
+			close_time TEXT NOT NULL,
+			slot_duration INTEGER NOT NULL
+		)`,
+		`CREATE TABLE blackouts (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			field_id INTEGER NOT NULL,
+			start_at DATETIME NOT NULL,
+			end_at DATETIME NOT NULL,
+			reason TEXT,
+			created_at DATETIME
+		)`,
+		`CREATE TABLE pricing_rules (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			field_id INTEGER NOT NULL,
+			name TEXT NOT NULL,
+			kind TEXT,
+			weekdays INTEGER NOT NULL,
+			start_time TEXT,
+			end_time TEXT,
+			valid_from DATETIME NULL,
+			valid_until DATETIME NULL,
+			percent INTEGER NOT NULL,
+			price INTEGER NOT NULL,
+			priority INTEGER NOT NULL
+		)`,
+		`CREATE TABLE transactions (
+			transaction_id TEXT PRIMARY KEY,
+			user_id INTEGER NOT NULL,
+			order_id TEXT,
+			payment_type TEXT,
+			payment_url TEXT,
+			payment_status TEXT,
+			total_price INTEGER NOT NULL,
+			transaction_time DATETIME NULL,
+			settlement_time DATETIME NULL,
+			fraud_status TEXT
+		)`,
+		`CREATE TABLE transaction_details (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			transaction_id TEXT NOT NULL,
+			schedule_id INTEGER NOT NULL,
+			name TEXT NOT NULL,
+			price INTEGER NOT NULL
+		)`,
+		`CREATE TABLE reviews (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			field_id INTEGER NOT NULL,
+			schedule_id INTEGER NOT NULL,
+			user_id INTEGER NOT NULL,
+			rating INTEGER NOT NULL,
+			comment TEXT,
+			created_at DATETIME
+		)`,
+		`CREATE TABLE outbox_events (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			source TEXT NOT NULL,
+			type TEXT NOT NULL,
+			aggregate_type TEXT NOT NULL,
+			aggregate_id TEXT NOT NULL,
+			payload TEXT NOT NULL,
+			created_at DATETIME,
+			published_at DATETIME NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			last_error TEXT
+		)`,
+	} {
+		if err := db.Exec(stmt).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return db
+}
+
+// statementManager runs fn without a transaction. sqlite locks the whole
+// database for a writing transaction, which would serialize the writers of a
+// test; run statement by statement they interleave the way they do on MySQL,
+// where a reader does not block a writer and the losing UPDATE re-reads the
+// committed row.
+type statementManager struct{}
+
+var _ txmanager.Manager = statementManager{}
+
+func (statementManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (statementManager) ReadOnly(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// barrierScheduleRepository holds every FindById until readers callers have
+// read their schedule, so all of them act on the same version.
+type barrierScheduleRepository struct {
+	repository.ScheduleRepository
+	read sync.WaitGroup
+}
+
+func newBarrierScheduleRepository(scheduleRepository repository.ScheduleRepository, readers int) *barrierScheduleRepository {
+	barrier := &barrierScheduleRepository{ScheduleRepository: scheduleRepository}
+	barrier.read.Add(readers)
+	return barrier
+}
+
+func (repository *barrierScheduleRepository) FindById(ctx context.Context, scheduleId uint) (*entity.Schedule, error) {
+	schedule, err := repository.ScheduleRepository.FindById(ctx, scheduleId)
+	repository.read.Done()
+	repository.read.Wait()
+	return schedule, err
+}
+
+func TestScheduleUpdateStatusSingleWinner(t *testing.T) {
+	db := newScheduleTestDB(t)
+	scheduleRepo := repository.NewScheduleRepository(db)
+
+	if err := db.Exec("INSERT INTO fields (id) VALUES (1)").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	date := time.Now().Add(24 * time.Hour)
+	schedule := entity.Schedule{
+		FieldId: 1,
+		Date:    date,
+		EndDate: date.Add(time.Hour),
+		Status:  entity.ScheduleStatusAvailable,
+	}
+	if _, err := scheduleRepo.Save(context.Background(), &schedule); err != nil {
+		t.Fatal(err)
+	}
+
+	const clients = 2
+
+	// both writers read version 0 before either of them writes
+	barrier := newBarrierScheduleRepository(scheduleRepo, clients)
+	service := NewScheduleUseCase(barrier, repository.NewFieldRepository(db), repository.NewBlackoutRepository(db), outboxkit.NewRepository(db), repository.NewTransactionRepository(db), broadcast.NewScheduleBroadcaster(0, 1), statementManager{}, validator.New(), 15*time.Minute)
+
+	var wg sync.WaitGroup
+	errs := make([]error, clients)
+	for i := 0; i < clients; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			caller := &dto.Caller{UserId: uint(i + 1), Role: string(entity.RoleUser)}
+			errs[i] = service.UpdateStatus(context.Background(), caller, &dto.ScheduleStatusRequest{
+				Status: string(entity.ScheduleStatusReserved),
+			}, schedule.Id)
+		}(i)
+	}
+	wg.Wait()
+
+	winner := uint(0)
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			if winner != 0 {
+				t.Fatalf("users %d and %d both reserved the schedule", winner, i+1)
+			}
+			winner = uint(i + 1)
+		case errors.Is(err, helper.ErrConcurrentUpdate):
+		default:
+			t.Fatalf("user %d: got %v, want %v", i+1, err, helper.ErrConcurrentUpdate)
+		}
+	}
+	if winner == 0 {
+		t.Fatal("no user reserved the schedule")
+	}
+
+	got, err := scheduleRepo.FindById(context.Background(), schedule.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != entity.ScheduleStatusReserved || got.UserId == nil || *got.UserId != winner {
+		t.Fatalf("schedule = %s by %v, want reserved by %d", got.Status, got.UserId, winner)
+	}
+	if got.Version != 1 {
+		t.Fatalf("version = %d, want 1", got.Version)
+	}
+
+	var events int64
+	if err := db.Table("outbox_events").Count(&events).Error; err != nil {
+		t.Fatal(err)
+	}
+	if events != 1 {
+		t.Fatalf("outbox has %d events, want 1", events)
+	}
+}
+
+func TestScheduleUpdateStatusStaleVersion(t *testing.T) {
+	db := newScheduleTestDB(t)
+	scheduleRepo := repository.NewScheduleRepository(db)
+
+	date := time.Now().Add(24 * time.Hour)
+	schedule := entity.Schedule{
+		FieldId: 1,
+		Date:    date,
+		EndDate: date.Add(time.Hour),
+		Status:  entity.ScheduleStatusAvailable,
+	}
+	if _, err := scheduleRepo.Save(context.Background(), &schedule); err != nil {
+		t.Fatal(err)
+	}
+
+	// both writers read version 0 before either of them writes
+	first, second := uint(1), uint(2)
+
+	updated, err := scheduleRepo.UpdateStatus(context.Background(), schedule.Id, schedule.Version, entity.ScheduleStatusReserved, &first, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated != 1 {
+		t.Fatalf("first writer updated %d rows, want 1", updated)
+	}
+
+	updated, err = scheduleRepo.UpdateStatus(context.Background(), schedule.Id, schedule.Version, entity.ScheduleStatusReserved, &second, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated != 0 {
+		t.Fatalf("stale writer updated %d rows, want 0", updated)
+	}
+}
+
+func TestScheduleMutationsRequireFieldOwner(t *testing.T) {
+	db := newScheduleTestDB(t)
+	ctx := context.Background()
+
+	if err := db.Exec("INSERT INTO fields (id, operator_id) VALUES (1, 7)").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	service := NewScheduleUseCase(repository.NewScheduleRepository(db), repository.NewFieldRepository(db), repository.NewBlackoutRepository(db), outboxkit.NewRepository(db), repository.NewTransactionRepository(db), broadcast.NewScheduleBroadcaster(0, 1), txmanager.NewManager(db), validator.New(), 15*time.Minute)
+
+	date := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	request := &dto.ScheduleRequest{FieldId: 1, Date: date, EndDate: date.Add(time.Hour)}
+
+	strangers := []*dto.Caller{
+		{UserId: 8, Role: string(entity.RoleOperator)},
+		{UserId: 7, Role: string(entity.RoleUser)},
+	}
+	for _, caller := range strangers {
+		if _, err := service.Save(ctx, caller, request); !errors.Is(err, helper.ErrPermissionDenied) {
+			t.Errorf("save by %s %d: got %v, want permission denied", caller.Role, caller.UserId, err)
+		}
+	}
+
+	schedule, err := service.Save(ctx, &dto.Caller{UserId: 7, Role: string(entity.RoleOperator)}, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, caller := range strangers {
+		if err := service.Delete(ctx, caller, schedule.Id); !errors.Is(err, helper.ErrPermissionDenied) {
+			t.Errorf("delete by %s %d: got %v, want permission denied", caller.Role, caller.UserId, err)
+		}
+	}
+
+	if err := service.Delete(ctx, &dto.Caller{UserId: 1, Role: string(entity.RoleSuperUser)}, schedule.Id); err != nil {
+		t.Errorf("delete by a super user: %v", err)
+	}
+}
+
+func TestScheduleUpdateStatusOfAnotherUser(t *testing.T) {
+	db := newScheduleTestDB(t)
+	ctx := context.Background()
+
+	if err := db.Exec("INSERT INTO fields (id, operator_id) VALUES (1, 7)").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	scheduleRepo := repository.NewScheduleRepository(db)
+	date := time.Now().Add(24 * time.Hour)
+	schedule := entity.Schedule{FieldId: 1, Date: date, EndDate: date.Add(time.Hour), Status: entity.ScheduleStatusAvailable}
+	if _, err := scheduleRepo.Save(ctx, &schedule); err != nil {
+		t.Fatal(err)
+	}
+
+	service := NewScheduleUseCase(scheduleRepo, repository.NewFieldRepository(db), repository.NewBlackoutRepository(db), outboxkit.NewRepository(db), repository.NewTransactionRepository(db), broadcast.NewScheduleBroadcaster(0, 1), txmanager.NewManager(db), validator.New(), 15*time.Minute)
+
+	holder := &dto.Caller{UserId: 1, Role: string(entity.RoleUser)}
+	other := &dto.Caller{UserId: 2, Role: string(entity.RoleUser)}
+	owner := &dto.Caller{UserId: 7, Role: string(entity.RoleOperator)}
+
+	update := func(caller *dto.Caller, status entity.ScheduleStatus, userId *uint) error {
+		return service.UpdateStatus(ctx, caller, &dto.ScheduleStatusRequest{Status: string(status), UserId: userId}, schedule.Id)
+	}
+
+	if err := update(holder, entity.ScheduleStatusReserved, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := update(other, entity.ScheduleStatusAvailable, nil); !errors.Is(err, helper.ErrPermissionDenied) {
+		t.Errorf("release by another user: got %v, want permission denied", err)
+	}
+	if err := update(other, entity.ScheduleStatusSold, &holder.UserId); !errors.Is(err, helper.ErrPermissionDenied) {
+		t.Errorf("sale for the holder by another user: got %v, want permission denied", err)
+	}
+	if err := update(other, entity.ScheduleStatusSold, nil); !errors.Is(err, helper.ErrScheduleTaken) {
+		t.Errorf("sale to another user: got %v, want schedule taken", err)
+	}
+
+	got, err := scheduleRepo.FindById(ctx, schedule.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != entity.ScheduleStatusReserved || got.UserId == nil || *got.UserId != holder.UserId {
+		t.Fatalf("schedule = %s by %v, want still reserved by %d", got.Status, got.UserId, holder.UserId)
+	}
+
+	//the field owner may release any hold
+	if err := update(owner, entity.ScheduleStatusAvailable, nil); err != nil {
+		t.Errorf("release by the field owner: %v", err)
+	}
+}
+
+// rowLockManager stands in for the row locks sqlite does not have: a field
+// family locked through rowLockFieldRepository stays locked until the unit of
+// work that locked it ends, as the row of its head does on MySQL.
+type rowLockManager struct {
+	mu   sync.Mutex
+	rows map[uint]*sync.Mutex
+}
+
+type heldRowsKey struct{}
+
+var _ txmanager.Manager = (*rowLockManager)(nil)
+
+func (manager *rowLockManager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	var held []*sync.Mutex
+	defer func() {
+		for _, row := range held {
+			row.Unlock()
+		}
+	}()
+
+	return fn(context.WithValue(ctx, heldRowsKey{}, &held))
+}
+
+func (manager *rowLockManager) ReadOnly(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (manager *rowLockManager) lock(ctx context.Context, id uint) {
+	manager.mu.Lock()
+	if manager.rows == nil {
+		manager.rows = make(map[uint]*sync.Mutex)
+	}
+	row, ok := manager.rows[id]
+	if !ok {
+		row = &sync.Mutex{}
+		manager.rows[id] = row
+	}
+	manager.mu.Unlock()
+
+	held := ctx.Value(heldRowsKey{}).(*[]*sync.Mutex)
+	for _, h := range *held {
+		if h == row {
+			return
+		}
+	}
+
+	row.Lock()
+	*held = append(*held, row)
+}
+
+type rowLockFieldRepository struct {
+	repository.FieldRepository
+	db    *gorm.DB
+	locks *rowLockManager
+}
+
+func (repository *rowLockFieldRepository) LockFamilies(ctx context.Context, fieldIds []uint) error {
+	if err := repository.FieldRepository.LockFamilies(ctx, fieldIds); err != nil {
+		return err
+	}
+
+	var heads []uint
+	if err := repository.db.Raw("SELECT DISTINCT COALESCE(parent_id, id) FROM fields WHERE id IN ? ORDER BY 1", fieldIds).Scan(&heads).Error; err != nil {
+		return err
+	}
+	for _, id := range heads {
+		repository.locks.lock(ctx, id)
+	}
+
+	return nil
+}
+
+// pairedScheduleRepository returns from LockRelated once the other booking
+// checked its related slots too, or after a grace period when it is kept from
+// getting there; without a lock held across the check both bookings pass it.
+type pairedScheduleRepository struct {
+	*barrierScheduleRepository
+	checked sync.WaitGroup
+}
+
+func (repository *pairedScheduleRepository) LockRelated(ctx context.Context, fieldId uint, start time.Time, end time.Time) (*[]entity.Schedule, error) {
+	related, err := repository.barrierScheduleRepository.LockRelated(ctx, fieldId, start, end)
+	repository.checked.Done()
+
+	both := make(chan struct{})
+	go func() {
+		repository.checked.Wait()
+		close(both)
+	}()
+	select {
+	case <-both:
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	return related, err
+}
+
+func TestScheduleUpdateStatusParentAndPart(t *testing.T) {
+	db := newScheduleTestDB(t)
+	ctx := context.Background()
+
+	if err := db.Exec("INSERT INTO fields (id, parent_id) VALUES (1, NULL), (2, 1)").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	scheduleRepo := repository.NewScheduleRepository(db)
+	date := time.Now().Add(24 * time.Hour)
+	parent := entity.Schedule{FieldId: 1, Date: date, EndDate: date.Add(time.Hour), Status: entity.ScheduleStatusAvailable}
+	part := entity.Schedule{FieldId: 2, Date: date, EndDate: date.Add(time.Hour), Status: entity.ScheduleStatusAvailable}
+	for _, schedule := range []*entity.Schedule{&parent, &part} {
+		if _, err := scheduleRepo.Save(ctx, schedule); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// both bookings read their slot before either of them locks anything, and
+	// check the other's slot at the same time unless a lock keeps them apart
+	locks := &rowLockManager{}
+	paired := &pairedScheduleRepository{barrierScheduleRepository: newBarrierScheduleRepository(scheduleRepo, 2)}
+	paired.checked.Add(2)
+	fieldRepo := &rowLockFieldRepository{FieldRepository: repository.NewFieldRepository(db), db: db, locks: locks}
+	service := NewScheduleUseCase(paired, fieldRepo, repository.NewBlackoutRepository(db), outboxkit.NewRepository(db), repository.NewTransactionRepository(db), broadcast.NewScheduleBroadcaster(0, 1), locks, validator.New(), 15*time.Minute)
+
+	scheduleIds := []uint{parent.Id, part.Id}
+	var wg sync.WaitGroup
+	errs := make([]error, len(scheduleIds))
+	for i, scheduleId := range scheduleIds {
+		wg.Add(1)
+		go func(i int, scheduleId uint) {
+			defer wg.Done()
+
+			caller := &dto.Caller{UserId: uint(i + 1), Role: string(entity.RoleUser)}
+			errs[i] = service.UpdateStatus(ctx, caller, &dto.ScheduleStatusRequest{
+				Status: string(entity.ScheduleStatusReserved),
+			}, scheduleId)
+		}(i, scheduleId)
+	}
+	wg.Wait()
+
+	booked := 0
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			booked++
+		case errors.Is(err, helper.ErrFieldPartBooked):
+		default:
+			t.Fatalf("booking %d: got %v, want success or %v", i, err, helper.ErrFieldPartBooked)
+		}
+	}
+	if booked != 1 {
+		t.Fatalf("%d of the parent and its part were booked at the same time, want 1", booked)
+	}
+}
@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/broadcast"
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/helper"
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	outboxkit "github.com/DevisArya/learn-microservices/svckit/outbox"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+)
+
+type TransactionUseCase interface {
+	Save(ctx context.Context, caller *dto.Caller, request *dto.TransactionRequest) (*entity.Transaction, error)
+	FindById(ctx context.Context, caller *dto.Caller, transactionId string) (*entity.Transaction, error)
+	FindAll(ctx context.Context, caller *dto.Caller, filter *dto.TransactionFilter, limit uint32, page uint32) (*[]entity.Transaction, *dto.PaginationResponse, error)
+}
+
+type TransactionUseCaseImpl struct {
+	TransactionRepository repository.TransactionRepository
+	ScheduleRepository    repository.ScheduleRepository
+	FieldRepository       repository.FieldRepository
+	BlackoutRepository    repository.BlackoutRepository
+	PricingRuleRepository repository.PricingRuleRepository
+	OutboxRepository      outboxkit.Repository
+	Broadcaster           broadcast.ScheduleBroadcaster
+	TxManager             txmanager.Manager
+	validate              *validator.Validate
+	HoldDuration          time.Duration
+}
+
+func NewTransactionUseCase(transactionRepository repository.TransactionRepository, scheduleRepository repository.ScheduleRepository, fieldRepository repository.FieldRepository, blackoutRepository repository.BlackoutRepository, pricingRuleRepository repository.PricingRuleRepository, outboxRepository outboxkit.Repository, broadcaster broadcast.ScheduleBroadcaster, txManager txmanager.Manager, validate *validator.Validate, holdDuration time.Duration) TransactionUseCase {
+	return &TransactionUseCaseImpl{
+		TransactionRepository: transactionRepository,
+		ScheduleRepository:    scheduleRepository,
+		FieldRepository:       fieldRepository,
+		BlackoutRepository:    blackoutRepository,
+		PricingRuleRepository: pricingRuleRepository,
+		OutboxRepository:      outboxRepository,
+		Broadcaster:           broadcaster,
+		TxManager:             txManager,
+		validate:              validate,
+		HoldDuration:          holdDuration,
+	}
+}
+
+// Save implements TransactionUseCase. Every schedule is priced with the pricing
+// rules of its field and reserved for the caller in one database transaction,
+// so either all of them are booked or none is. The name and price of each slot
+// are copied into the details, later changes to the field leave the
+// transaction as it was sold.
+func (service *TransactionUseCaseImpl) Save(ctx context.Context, caller *dto.Caller, request *dto.TransactionRequest) (*entity.Transaction, error) {
+
+	if err := service.validate.Struct(request); err != nil {
+		return nil, err
+	}
+
+	var transaction *entity.Transaction
+	var events []dto.ScheduleEvent
+	err := service.TxManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		transaction, events, err = service.save(ctx, caller, request)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	//watchers only hear about committed changes
+	for _, event := range events {
+		service.Broadcaster.Publish(event)
+	}
+
+	return transaction, nil
+}
+
+func (service *TransactionUseCaseImpl) save(ctx context.Context, caller *dto.Caller, request *dto.TransactionRequest) (*entity.Transaction, []dto.ScheduleEvent, error) {
+
+	schedules, err := service.ScheduleRepository.FindByIds(ctx, request.ScheduleIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(*schedules) != len(request.ScheduleIds) {
+		return nil, nil, apperror.NotFound("schedule not found")
+	}
+
+	transactionId, err := helper.NewTransactionId()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	var holdExpiresAt *time.Time
+	if service.HoldDuration > 0 {
+		expiresAt := now.Add(service.HoldDuration)
+		holdExpiresAt = &expiresAt
+	}
+
+	transaction := entity.Transaction{
+		TransactionId:   transactionId,
+		UserId:          caller.UserId,
+		PaymentStatus:   string(entity.PaymentStatusUnpaid),
+		TransactionTime: now,
+	}
+
+	//every family is locked up front in id order, a booking spanning several
+	//of them would otherwise lock them in the order of its slots
+	fieldIds := make([]uint, 0, len(*schedules))
+	for _, schedule := range *schedules {
+		fieldIds = append(fieldIds, schedule.FieldId)
+	}
+	if err := service.FieldRepository.LockFamilies(ctx, fieldIds); err != nil {
+		return nil, nil, err
+	}
+
+	fields := make(map[uint]*entity.Field)
+	rules := make(map[uint][]entity.PricingRule)
+	events := make([]dto.ScheduleEvent, 0, len(*schedules))
+
+	for _, schedule := range *schedules {
+		if schedule.Status == entity.ScheduleStatusSold || scheduleTaken(&schedule, caller.UserId, now) {
+			return nil, nil, helper.ErrScheduleTaken
+		}
+
+		if !schedule.Date.After(now) {
+			return nil, nil, apperror.FailedPrecondition("schedule has already started")
+		}
+
+		if err := checkBlackout(ctx, service.BlackoutRepository, schedule.FieldId, schedule.Date, schedule.EndDate); err != nil {
+			return nil, nil, err
+		}
+
+		if err := checkRelatedBooked(ctx, service.FieldRepository, service.ScheduleRepository, &schedule, now); err != nil {
+			return nil, nil, err
+		}
+
+		field, ok := fields[schedule.FieldId]
+		if !ok {
+			field, err = service.FieldRepository.FindById(ctx, schedule.FieldId)
+			if err != nil {
+				return nil, nil, err
+			}
+			fieldRules, err := service.PricingRuleRepository.FindByField(ctx, schedule.FieldId)
+			if err != nil {
+				return nil, nil, err
+			}
+			fields[schedule.FieldId] = field
+			rules[schedule.FieldId] = *fieldRules
+		}
+
+		quote := quoteSlot(field, rules[schedule.FieldId], &schedule)
+
+		updated, err := service.ScheduleRepository.UpdateStatus(ctx, schedule.Id, schedule.Version, entity.ScheduleStatusReserved, &caller.UserId, holdExpiresAt)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if updated == 0 {
+			return nil, nil, helper.ErrConcurrentUpdate
+		}
+
+		transaction.TotalPrice += quote.Total
+		transaction.TransactionDetail = append(transaction.TransactionDetail, entity.TransactionDetail{
+			ScheduleId: schedule.Id,
+			Name:       slotName(field, &schedule),
+			Price:      quote.Total,
+		})
+
+		event := dto.ScheduleEvent{
+			Schedule:       schedule,
+			PreviousStatus: schedule.Status,
+			OccurredAt:     now,
+		}
+		event.Schedule.Status = entity.ScheduleStatusReserved
+		event.Schedule.UserId = &caller.UserId
+		event.Schedule.HoldExpiresAt = holdExpiresAt
+		event.Schedule.Version++
+		events = append(events, event)
+
+		if err := scheduleReserved(ctx, service.OutboxRepository, &event.Schedule); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	response, err := service.TransactionRepository.Save(ctx, &transaction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return response, events, nil
+}
+
+// FindById implements TransactionUseCase. A transaction is only shown to the
+// user who made it and to super users.
+func (service *TransactionUseCaseImpl) FindById(ctx context.Context, caller *dto.Caller, transactionId string) (*entity.Transaction, error) {
+
+	transaction, err := service.TransactionRepository.FindById(ctx, transactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if transaction.UserId != caller.UserId && entity.Role(caller.Role) != entity.RoleSuperUser {
+		return nil, helper.ErrPermissionDenied
+	}
+
+	return transaction, nil
+}
+
+// FindAll implements TransactionUseCase. Users list their own transactions; a
+// super user lists those of filter.UserId, or of every user when it is nil.
+func (service *TransactionUseCaseImpl) FindAll(ctx context.Context, caller *dto.Caller, filter *dto.TransactionFilter, limit uint32, page uint32) (*[]entity.Transaction, *dto.PaginationResponse, error) {
+
+	if entity.Role(caller.Role) != entity.RoleSuperUser {
+		if filter.UserId != nil && *filter.UserId != caller.UserId {
+			return nil, nil, helper.ErrPermissionDenied
+		}
+		filter.UserId = &caller.UserId
+	}
+
+	if page < 1 {
+		page = 1
+	}
+
+	if limit < 1 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	var transactions *[]entity.Transaction
+	var totalRecord int64
+	err := service.TxManager.ReadOnly(ctx, func(ctx context.Context) error {
+		var err error
+		transactions, totalRecord, err = service.TransactionRepository.FindAll(ctx, filter, limit, offset)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalPage := (totalRecord + int64(limit) - 1) / int64(limit)
+
+	return transactions, &dto.PaginationResponse{
+		CurrentPage: page,
+		Limit:       limit,
+		TotalRecord: uint32(totalRecord),
+		TotalPage:   uint32(totalPage),
+	}, nil
+}
+
+// slotName describes a booked schedule for its transaction detail, in the
+// time zone of the field.
+func slotName(field *entity.Field, schedule *entity.Schedule) string {
+	loc := field.Location()
+	return fmt.Sprintf("%s, %s - %s",
+		field.Name,
+		schedule.Date.In(loc).Format("Mon 2 Jan 2006 15:04"),
+		schedule.EndDate.In(loc).Format("15:04 MST"),
+	)
+}
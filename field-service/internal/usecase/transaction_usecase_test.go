@@ -0,0 +1,103 @@
+//go:build cgo
+
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/broadcast"
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	outboxkit "github.com/DevisArya/learn-microservices/svckit/outbox"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+)
+
+func TestTransactionSaveAfterHoldSweep(t *testing.T) {
+	db := newScheduleTestDB(t)
+	ctx := context.Background()
+
+	if err := db.Exec("INSERT INTO fields (id) VALUES (1)").Error; err != nil {
+		t.Fatal(err)
+	}
+
+	scheduleRepo := repository.NewScheduleRepository(db)
+	date := time.Now().Add(24 * time.Hour)
+	schedule := entity.Schedule{
+		FieldId: 1,
+		Date:    date,
+		EndDate: date.Add(time.Hour),
+		Status:  entity.ScheduleStatusAvailable,
+	}
+	if _, err := scheduleRepo.Save(ctx, &schedule); err != nil {
+		t.Fatal(err)
+	}
+
+	const holdDuration = 15 * time.Minute
+
+	fieldRepo := repository.NewFieldRepository(db)
+	blackoutRepo := repository.NewBlackoutRepository(db)
+	outboxRepo := outboxkit.NewRepository(db)
+	transactionRepo := repository.NewTransactionRepository(db)
+	broadcaster := broadcast.NewScheduleBroadcaster(0, 1)
+	txManager := txmanager.NewManager(db)
+	validate := validator.New()
+
+	scheduleService := NewScheduleUseCase(scheduleRepo, fieldRepo, blackoutRepo, outboxRepo, transactionRepo, broadcaster, txManager, validate, holdDuration)
+	transactionService := NewTransactionUseCase(transactionRepo, scheduleRepo, fieldRepo, blackoutRepo, repository.NewPricingRuleRepository(db), outboxRepo, broadcaster, txManager, validate, holdDuration)
+
+	request := &dto.TransactionRequest{ScheduleIds: []uint{schedule.Id}}
+
+	first, err := transactionService.Save(ctx, &dto.Caller{UserId: 1, Role: string(entity.RoleUser)}, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//let the hold run out
+	if err := db.Exec("UPDATE schedules SET hold_expires_at = ? WHERE id = ?", time.Now().Add(-time.Minute), schedule.Id).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	released, err := scheduleService.ReleaseExpiredHolds(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if released != 1 {
+		t.Fatalf("released %d holds, want 1", released)
+	}
+
+	second, err := transactionService.Save(ctx, &dto.Caller{UserId: 2, Role: string(entity.RoleUser)}, request)
+	if err != nil {
+		t.Fatalf("booking the released slot: %v", err)
+	}
+
+	expired, err := transactionRepo.FindById(ctx, first.TransactionId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expired.PaymentStatus != string(entity.PaymentStatusExpired) {
+		t.Fatalf("first transaction is %s, want %s", expired.PaymentStatus, entity.PaymentStatusExpired)
+	}
+
+	var unpaid int64
+	if err := db.Table("transactions").
+		Joins("JOIN transaction_details ON transaction_details.transaction_id = transactions.transaction_id").
+		Where("transaction_details.schedule_id = ? AND transactions.payment_status = ?", schedule.Id, entity.PaymentStatusUnpaid).
+		Count(&unpaid).Error; err != nil {
+		t.Fatal(err)
+	}
+	if unpaid != 1 {
+		t.Fatalf("%d unpaid transactions book the slot, want 1", unpaid)
+	}
+
+	got, err := scheduleRepo.FindById(ctx, schedule.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != entity.ScheduleStatusReserved || got.UserId == nil || *got.UserId != 2 {
+		t.Fatalf("schedule = %s by %v, want reserved by 2 for %s", got.Status, got.UserId, second.TransactionId)
+	}
+}
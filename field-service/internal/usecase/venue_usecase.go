@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/dto"
+	"github.com/DevisArya/learn-microservices/field-service/internal/entity"
+	"github.com/DevisArya/learn-microservices/field-service/internal/helper"
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+)
+
+type VenueUseCase interface {
+	Save(ctx context.Context, request *dto.VenueRequest) (*entity.Venue, error)
+	Update(ctx context.Context, request *dto.VenueRequest, id uint) error
+	Delete(ctx context.Context, venueId uint) error
+	FindById(ctx context.Context, venueId uint) (*entity.Venue, error)
+	FindAll(ctx context.Context, limit uint32, page uint32) (*[]entity.Venue, *dto.PaginationResponse, error)
+	FindNearest(ctx context.Context, request *dto.NearestVenueRequest) (*[]dto.VenueDistance, error)
+}
+
+type VenueUseCaseImpl struct {
+	VenueRepository repository.VenueRepository
+	TxManager       txmanager.Manager
+	validate        *validator.Validate
+}
+
+func NewVenueUseCase(venueRepository repository.VenueRepository, txManager txmanager.Manager, validate *validator.Validate) VenueUseCase {
+	return &VenueUseCaseImpl{
+		VenueRepository: venueRepository,
+		TxManager:       txManager,
+		validate:        validate,
+	}
+}
+
+// Save implements VenueUseCase
+func (service *VenueUseCaseImpl) Save(ctx context.Context, request *dto.VenueRequest) (*entity.Venue, error) {
+
+	if err := service.validate.Struct(request); err != nil {
+		return nil, err
+	}
+
+	venueData := entity.Venue{
+		Name:         request.Name,
+		Address:      request.Address,
+		Latitude:     request.Latitude,
+		Longitude:    request.Longitude,
+		PhoneNumber:  request.PhoneNumber,
+		Email:        request.Email,
+		OpeningHours: request.OpeningHours,
+		Timezone:     request.Timezone,
+	}
+	if venueData.Timezone == "" {
+		venueData.Timezone = time.UTC.String()
+	}
+
+	response, err := service.VenueRepository.Save(ctx, &venueData)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Update implements VenueUseCase
+func (service *VenueUseCaseImpl) Update(ctx context.Context, request *dto.VenueRequest, id uint) error {
+
+	if err := service.validate.Struct(request); err != nil {
+		return err
+	}
+
+	return service.TxManager.Do(ctx, func(ctx context.Context) error {
+		venue, err := service.VenueRepository.FindById(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		venueData := entity.Venue{
+			Id:           id,
+			Name:         request.Name,
+			Address:      request.Address,
+			Latitude:     request.Latitude,
+			Longitude:    request.Longitude,
+			PhoneNumber:  request.PhoneNumber,
+			Email:        request.Email,
+			OpeningHours: request.OpeningHours,
+			Timezone:     request.Timezone,
+		}
+		if venueData.Timezone == "" {
+			venueData.Timezone = venue.Timezone
+		}
+
+		if err := service.VenueRepository.Update(ctx, &venueData); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// Delete implements VenueUseCase
+func (service *VenueUseCaseImpl) Delete(ctx context.Context, venueId uint) error {
+
+	return service.TxManager.Do(ctx, func(ctx context.Context) error {
+		if _, err := service.VenueRepository.FindById(ctx, venueId); err != nil {
+			return err
+		}
+
+		//deleting the venue would detach the soft deleted fields as well
+		fieldCount, err := service.VenueRepository.CountFields(ctx, venueId)
+		if err != nil {
+			return err
+		}
+
+		if fieldCount > 0 {
+			return apperror.FailedPrecondition("venue still has fields")
+		}
+
+		if err := service.VenueRepository.Delete(ctx, venueId); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// FindById implements VenueUseCase
+func (service *VenueUseCaseImpl) FindById(ctx context.Context, venueId uint) (*entity.Venue, error) {
+
+	venue, err := service.VenueRepository.FindById(ctx, venueId)
+	if err != nil {
+		return nil, err
+	}
+
+	return venue, nil
+}
+
+// FindAll implements VenueUseCase
+func (service *VenueUseCaseImpl) FindAll(ctx context.Context, limit uint32, page uint32) (*[]entity.Venue, *dto.PaginationResponse, error) {
+
+	if page < 1 {
+		page = 1
+	}
+
+	if limit < 1 {
+		limit = 10
+	}
+
+	offset := (page - 1) * limit
+
+	//the page and its total are read from one snapshot
+	var venues *[]entity.Venue
+	var totalRecord int64
+	err := service.TxManager.ReadOnly(ctx, func(ctx context.Context) error {
+		var err error
+		venues, totalRecord, err = service.VenueRepository.FindAll(ctx, limit, offset)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	totalPage := (totalRecord + int64(limit) - 1) / int64(limit)
+
+	return venues, &dto.PaginationResponse{
+		CurrentPage: page,
+		Limit:       limit,
+		TotalRecord: uint32(totalRecord),
+		TotalPage:   uint32(totalPage),
+	}, nil
+}
+
+// FindNearest implements VenueUseCase. Distances are computed in-process with
+// the haversine formula; a max distance narrows the rows loaded to its
+// bounding box first.
+func (service *VenueUseCaseImpl) FindNearest(ctx context.Context, request *dto.NearestVenueRequest) (*[]dto.VenueDistance, error) {
+
+	if err := service.validate.Struct(request); err != nil {
+		return nil, err
+	}
+
+	limit := request.Limit
+	if limit < 1 {
+		limit = 10
+	}
+
+	minLat, maxLat, minLng, maxLng := -90.0, 90.0, -180.0, 180.0
+	if request.MaxDistanceKm > 0 {
+		minLat, maxLat, minLng, maxLng = helper.BoundingBox(request.Latitude, request.Longitude, request.MaxDistanceKm)
+	}
+
+	venues, err := service.VenueRepository.FindWithin(ctx, minLat, maxLat, minLng, maxLng)
+	if err != nil {
+		return nil, err
+	}
+
+	nearest := make([]dto.VenueDistance, 0, len(*venues))
+	for _, v := range *venues {
+		distance := helper.Haversine(request.Latitude, request.Longitude, v.Latitude, v.Longitude)
+		if request.MaxDistanceKm > 0 && distance > request.MaxDistanceKm {
+			continue
+		}
+		nearest = append(nearest, dto.VenueDistance{
+			Venue:      v,
+			DistanceKm: distance,
+		})
+	}
+
+	sort.Slice(nearest, func(i, j int) bool {
+		return nearest[i].DistanceKm < nearest[j].DistanceKm
+	})
+
+	if uint32(len(nearest)) > limit {
+		nearest = nearest[:limit]
+	}
+
+	return &nearest, nil
+}
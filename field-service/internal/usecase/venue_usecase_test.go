@@ -0,0 +1,87 @@
+//go:build cgo
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/field-service/internal/repository"
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/go-playground/validator/v10"
+	"gorm.io/gorm"
+)
+
+func TestVenueDeleteKeepsVenueOfDeletedFields(t *testing.T) {
+	db := newScheduleTestDB(t)
+	ctx := context.Background()
+
+	deletedAt := time.Now().Add(-time.Hour)
+	statements := []struct {
+		sql  string
+		args []any
+	}{
+		{"INSERT INTO venues (id, name) VALUES (1, 'live'), (2, 'deleted'), (3, 'empty')", nil},
+		{"INSERT INTO fields (id, venue_id) VALUES (1, 1)", nil},
+		{"INSERT INTO fields (id, venue_id, deleted_at) VALUES (2, 2, ?)", []any{deletedAt}},
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement.sql, statement.args...).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	service := NewVenueUseCase(repository.NewVenueRepository(db), txmanager.NewManager(db), validator.New())
+
+	tests := []struct {
+		name    string
+		venueId uint
+		kind    apperror.Kind
+		err     error
+		remains bool
+	}{
+		{name: "with a field", venueId: 1, kind: apperror.KindFailedPrecondition, remains: true},
+		{name: "with a soft deleted field", venueId: 2, kind: apperror.KindFailedPrecondition, remains: true},
+		{name: "without fields", venueId: 3},
+		{name: "missing", venueId: 4, err: gorm.ErrRecordNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.Delete(ctx, tt.venueId)
+
+			switch {
+			case tt.err != nil:
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("got %v, want %v", err, tt.err)
+				}
+			case tt.kind != apperror.KindInternal:
+				if apperror.KindOf(err) != tt.kind {
+					t.Fatalf("got %v, want kind %d", err, tt.kind)
+				}
+			case err != nil:
+				t.Fatal(err)
+			}
+
+			var venues int64
+			if err := db.Table("venues").Where("id = ?", tt.venueId).Count(&venues).Error; err != nil {
+				t.Fatal(err)
+			}
+			if remains := venues > 0; remains != tt.remains {
+				t.Errorf("venue remains %t, want %t", remains, tt.remains)
+			}
+		})
+	}
+
+	//the soft deleted field still belongs to its venue
+	var venueId *uint
+	if err := db.Raw("SELECT venue_id FROM fields WHERE id = 2").Scan(&venueId).Error; err != nil {
+		t.Fatal(err)
+	}
+	if venueId == nil || *venueId != 2 {
+		t.Errorf("got venue %v of the deleted field, want 2", venueId)
+	}
+}
@@ -0,0 +1,1622 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: field/field.proto
+
+package field
+
+import (
+	pagination "github.com/DevisArya/learn-microservices-protorepo/pb/pagination"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Id struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Id) Reset() {
+	*x = Id{}
+	mi := &file_field_field_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Id) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Id) ProtoMessage() {}
+
+func (x *Id) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Id.ProtoReflect.Descriptor instead.
+func (*Id) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Id) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetFieldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	MinPrice      *uint64                `protobuf:"varint,4,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice      *uint64                `protobuf:"varint,5,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	Search        string                 `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`
+	SortBy        string                 `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	SortOrder     string                 `protobuf:"bytes,8,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	Cursor        *string                `protobuf:"bytes,9,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	WithCount     bool                   `protobuf:"varint,10,opt,name=with_count,json=withCount,proto3" json:"with_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFieldsRequest) Reset() {
+	*x = GetFieldsRequest{}
+	mi := &file_field_field_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFieldsRequest) ProtoMessage() {}
+
+func (x *GetFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFieldsRequest.ProtoReflect.Descriptor instead.
+func (*GetFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetFieldsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFieldsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetFieldsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetFieldsRequest) GetMinPrice() uint64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *GetFieldsRequest) GetMaxPrice() uint64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+func (x *GetFieldsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *GetFieldsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetFieldsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *GetFieldsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *GetFieldsRequest) GetWithCount() bool {
+	if x != nil {
+		return x.WithCount
+	}
+	return false
+}
+
+type Field struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         uint64                 `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	VenueId       uint32                 `protobuf:"varint,7,opt,name=venue_id,json=venueId,proto3" json:"venue_id,omitempty"`
+	OperatorId    uint32                 `protobuf:"varint,8,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	RatingAverage float64                `protobuf:"fixed64,9,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"`
+	RatingCount   uint32                 `protobuf:"varint,10,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,11,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Timezone      string                 `protobuf:"bytes,12,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// min_duration, max_duration and granularity bound a booking, in minutes.
+	MinDuration   uint32 `protobuf:"varint,13,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	MaxDuration   uint32 `protobuf:"varint,14,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
+	Granularity   uint32 `protobuf:"varint,15,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Field) Reset() {
+	*x = Field{}
+	mi := &file_field_field_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Field) ProtoMessage() {}
+
+func (x *Field) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Field.ProtoReflect.Descriptor instead.
+func (*Field) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Field) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Field) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Field) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Field) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Field) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Field) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Field) GetVenueId() uint32 {
+	if x != nil {
+		return x.VenueId
+	}
+	return 0
+}
+
+func (x *Field) GetOperatorId() uint32 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *Field) GetRatingAverage() float64 {
+	if x != nil {
+		return x.RatingAverage
+	}
+	return 0
+}
+
+func (x *Field) GetRatingCount() uint32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Field) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Field) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Field) GetMinDuration() uint32 {
+	if x != nil {
+		return x.MinDuration
+	}
+	return 0
+}
+
+func (x *Field) GetMaxDuration() uint32 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+func (x *Field) GetGranularity() uint32 {
+	if x != nil {
+		return x.Granularity
+	}
+	return 0
+}
+
+type GetFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *pagination.Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data          []*Field               `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFieldsResponse) Reset() {
+	*x = GetFieldsResponse{}
+	mi := &file_field_field_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFieldsResponse) ProtoMessage() {}
+
+func (x *GetFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFieldsResponse.ProtoReflect.Descriptor instead.
+func (*GetFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetFieldsResponse) GetPagination() *pagination.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetFieldsResponse) GetData() []*Field {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         *Field                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFieldResponse) Reset() {
+	*x = GetFieldResponse{}
+	mi := &file_field_field_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFieldResponse) ProtoMessage() {}
+
+func (x *GetFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFieldResponse.ProtoReflect.Descriptor instead.
+func (*GetFieldResponse) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetFieldResponse) GetField() *Field {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+type CreateFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         uint64                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	VenueId       *uint32                `protobuf:"varint,5,opt,name=venue_id,json=venueId,proto3,oneof" json:"venue_id,omitempty"`
+	OperatorId    *uint32                `protobuf:"varint,6,opt,name=operator_id,json=operatorId,proto3,oneof" json:"operator_id,omitempty"`
+	ParentId      *uint32                `protobuf:"varint,7,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Timezone      string                 `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	MinDuration   uint32                 `protobuf:"varint,9,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	MaxDuration   uint32                 `protobuf:"varint,10,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`
+	Granularity   uint32                 `protobuf:"varint,11,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFieldRequest) Reset() {
+	*x = CreateFieldRequest{}
+	mi := &file_field_field_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFieldRequest) ProtoMessage() {}
+
+func (x *CreateFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFieldRequest.ProtoReflect.Descriptor instead.
+func (*CreateFieldRequest) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateFieldRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFieldRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateFieldRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateFieldRequest) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateFieldRequest) GetVenueId() uint32 {
+	if x != nil && x.VenueId != nil {
+		return *x.VenueId
+	}
+	return 0
+}
+
+func (x *CreateFieldRequest) GetOperatorId() uint32 {
+	if x != nil && x.OperatorId != nil {
+		return *x.OperatorId
+	}
+	return 0
+}
+
+func (x *CreateFieldRequest) GetParentId() uint32 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateFieldRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateFieldRequest) GetMinDuration() uint32 {
+	if x != nil {
+		return x.MinDuration
+	}
+	return 0
+}
+
+func (x *CreateFieldRequest) GetMaxDuration() uint32 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+func (x *CreateFieldRequest) GetGranularity() uint32 {
+	if x != nil {
+		return x.Granularity
+	}
+	return 0
+}
+
+type CreateFieldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFieldResponse) Reset() {
+	*x = CreateFieldResponse{}
+	mi := &file_field_field_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFieldResponse) ProtoMessage() {}
+
+func (x *CreateFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFieldResponse.ProtoReflect.Descriptor instead.
+func (*CreateFieldResponse) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateFieldResponse) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateFieldResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateFieldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Type          *string                `protobuf:"bytes,3,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Price         *uint64                `protobuf:"varint,5,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	VenueId       *uint32                `protobuf:"varint,6,opt,name=venue_id,json=venueId,proto3,oneof" json:"venue_id,omitempty"`
+	ParentId      *uint32                `protobuf:"varint,7,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Timezone      *string                `protobuf:"bytes,8,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	MinDuration   *uint32                `protobuf:"varint,9,opt,name=min_duration,json=minDuration,proto3,oneof" json:"min_duration,omitempty"`
+	MaxDuration   *uint32                `protobuf:"varint,10,opt,name=max_duration,json=maxDuration,proto3,oneof" json:"max_duration,omitempty"`
+	Granularity   *uint32                `protobuf:"varint,11,opt,name=granularity,proto3,oneof" json:"granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFieldRequest) Reset() {
+	*x = UpdateFieldRequest{}
+	mi := &file_field_field_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFieldRequest) ProtoMessage() {}
+
+func (x *UpdateFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFieldRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFieldRequest) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateFieldRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateFieldRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateFieldRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *UpdateFieldRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateFieldRequest) GetPrice() uint64 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *UpdateFieldRequest) GetVenueId() uint32 {
+	if x != nil && x.VenueId != nil {
+		return *x.VenueId
+	}
+	return 0
+}
+
+func (x *UpdateFieldRequest) GetParentId() uint32 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *UpdateFieldRequest) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateFieldRequest) GetMinDuration() uint32 {
+	if x != nil && x.MinDuration != nil {
+		return *x.MinDuration
+	}
+	return 0
+}
+
+func (x *UpdateFieldRequest) GetMaxDuration() uint32 {
+	if x != nil && x.MaxDuration != nil {
+		return *x.MaxDuration
+	}
+	return 0
+}
+
+func (x *UpdateFieldRequest) GetGranularity() uint32 {
+	if x != nil && x.Granularity != nil {
+		return *x.Granularity
+	}
+	return 0
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	mi := &file_field_field_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListDeletedFieldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedFieldsRequest) Reset() {
+	*x = ListDeletedFieldsRequest{}
+	mi := &file_field_field_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedFieldsRequest) ProtoMessage() {}
+
+func (x *ListDeletedFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListDeletedFieldsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedFieldsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	MinPrice      *uint64                `protobuf:"varint,4,opt,name=min_price,json=minPrice,proto3,oneof" json:"min_price,omitempty"`
+	MaxPrice      *uint64                `protobuf:"varint,5,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`
+	MinSlots      uint32                 `protobuf:"varint,6,opt,name=min_slots,json=minSlots,proto3" json:"min_slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAvailabilityRequest) Reset() {
+	*x = SearchAvailabilityRequest{}
+	mi := &file_field_field_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAvailabilityRequest) ProtoMessage() {}
+
+func (x *SearchAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SearchAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SearchAvailabilityRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *SearchAvailabilityRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *SearchAvailabilityRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SearchAvailabilityRequest) GetMinPrice() uint64 {
+	if x != nil && x.MinPrice != nil {
+		return *x.MinPrice
+	}
+	return 0
+}
+
+func (x *SearchAvailabilityRequest) GetMaxPrice() uint64 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
+	}
+	return 0
+}
+
+func (x *SearchAvailabilityRequest) GetMinSlots() uint32 {
+	if x != nil {
+		return x.MinSlots
+	}
+	return 0
+}
+
+type Slot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Slot) Reset() {
+	*x = Slot{}
+	mi := &file_field_field_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Slot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slot) ProtoMessage() {}
+
+func (x *Slot) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slot.ProtoReflect.Descriptor instead.
+func (*Slot) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Slot) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Slot) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Slot) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type FieldAvailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         *Field                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Slots         []*Slot                `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldAvailability) Reset() {
+	*x = FieldAvailability{}
+	mi := &file_field_field_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldAvailability) ProtoMessage() {}
+
+func (x *FieldAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldAvailability.ProtoReflect.Descriptor instead.
+func (*FieldAvailability) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FieldAvailability) GetField() *Field {
+	if x != nil {
+		return x.Field
+	}
+	return nil
+}
+
+func (x *FieldAvailability) GetSlots() []*Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type SearchAvailabilityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*FieldAvailability   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAvailabilityResponse) Reset() {
+	*x = SearchAvailabilityResponse{}
+	mi := &file_field_field_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAvailabilityResponse) ProtoMessage() {}
+
+func (x *SearchAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*SearchAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchAvailabilityResponse) GetData() []*FieldAvailability {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ImportFieldsRequest carries a chunk of the uploaded file. Format ("csv" or
+// "jsonl") and mode ("all_or_nothing" or "best_effort") are read from the
+// first message of the stream.
+type ImportFieldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFieldsRequest) Reset() {
+	*x = ImportFieldsRequest{}
+	mi := &file_field_field_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFieldsRequest) ProtoMessage() {}
+
+func (x *ImportFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ImportFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportFieldsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportFieldsRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ImportFieldsRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ImportRowError reports a rejected row by its 1-based line in the file and
+// the validation rule it violated.
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           uint32                 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Field         string                 `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Rule          string                 `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_field_field_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportRowError) GetRow() uint32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalRows     uint32                 `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	Ids           []uint32               `protobuf:"varint,2,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Errors        []*ImportRowError      `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportFieldsResponse) Reset() {
+	*x = ImportFieldsResponse{}
+	mi := &file_field_field_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportFieldsResponse) ProtoMessage() {}
+
+func (x *ImportFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportFieldsResponse.ProtoReflect.Descriptor instead.
+func (*ImportFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportFieldsResponse) GetTotalRows() uint32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportFieldsResponse) GetIds() []uint32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ImportFieldsResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ExportFieldsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Search        string                 `protobuf:"bytes,3,opt,name=search,proto3" json:"search,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportFieldsRequest) Reset() {
+	*x = ExportFieldsRequest{}
+	mi := &file_field_field_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportFieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFieldsRequest) ProtoMessage() {}
+
+func (x *ExportFieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFieldsRequest.ProtoReflect.Descriptor instead.
+func (*ExportFieldsRequest) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExportFieldsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportFieldsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ExportFieldsRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+type ExportFieldsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportFieldsResponse) Reset() {
+	*x = ExportFieldsResponse{}
+	mi := &file_field_field_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportFieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportFieldsResponse) ProtoMessage() {}
+
+func (x *ExportFieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_field_field_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportFieldsResponse.ProtoReflect.Descriptor instead.
+func (*ExportFieldsResponse) Descriptor() ([]byte, []int) {
+	return file_field_field_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportFieldsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_field_field_proto protoreflect.FileDescriptor
+
+const file_field_field_proto_rawDesc = "" +
+	"\n" +
+	"\x11field/field.proto\x12\x05field\x1a\x1bpagination/pagination.proto\"\x14\n" +
+	"\x02Id\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\xc7\x02\n" +
+	"\x10GetFieldsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12 \n" +
+	"\tmin_price\x18\x04 \x01(\x04H\x00R\bminPrice\x88\x01\x01\x12 \n" +
+	"\tmax_price\x18\x05 \x01(\x04H\x01R\bmaxPrice\x88\x01\x01\x12\x16\n" +
+	"\x06search\x18\x06 \x01(\tR\x06search\x12\x17\n" +
+	"\asort_by\x18\a \x01(\tR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\b \x01(\tR\tsortOrder\x12\x1b\n" +
+	"\x06cursor\x18\t \x01(\tH\x02R\x06cursor\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"with_count\x18\n" +
+	" \x01(\bR\twithCountB\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_priceB\t\n" +
+	"\a_cursor\"\xbd\x03\n" +
+	"\x05Field\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x04R\x05price\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\tR\tdeletedAt\x12\x19\n" +
+	"\bvenue_id\x18\a \x01(\rR\avenueId\x12\x1f\n" +
+	"\voperator_id\x18\b \x01(\rR\n" +
+	"operatorId\x12%\n" +
+	"\x0erating_average\x18\t \x01(\x01R\rratingAverage\x12!\n" +
+	"\frating_count\x18\n" +
+	" \x01(\rR\vratingCount\x12\x1b\n" +
+	"\tparent_id\x18\v \x01(\rR\bparentId\x12\x1a\n" +
+	"\btimezone\x18\f \x01(\tR\btimezone\x12!\n" +
+	"\fmin_duration\x18\r \x01(\rR\vminDuration\x12!\n" +
+	"\fmax_duration\x18\x0e \x01(\rR\vmaxDuration\x12 \n" +
+	"\vgranularity\x18\x0f \x01(\rR\vgranularity\"m\n" +
+	"\x11GetFieldsResponse\x126\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x16.pagination.PaginationR\n" +
+	"pagination\x12 \n" +
+	"\x04data\x18\x02 \x03(\v2\f.field.FieldR\x04data\"6\n" +
+	"\x10GetFieldResponse\x12\"\n" +
+	"\x05field\x18\x01 \x01(\v2\f.field.FieldR\x05field\"\x8b\x03\n" +
+	"\x12CreateFieldRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x04R\x05price\x12\x1e\n" +
+	"\bvenue_id\x18\x05 \x01(\rH\x00R\avenueId\x88\x01\x01\x12$\n" +
+	"\voperator_id\x18\x06 \x01(\rH\x01R\n" +
+	"operatorId\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\a \x01(\rH\x02R\bparentId\x88\x01\x01\x12\x1a\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\x12!\n" +
+	"\fmin_duration\x18\t \x01(\rR\vminDuration\x12!\n" +
+	"\fmax_duration\x18\n" +
+	" \x01(\rR\vmaxDuration\x12 \n" +
+	"\vgranularity\x18\v \x01(\rR\vgranularityB\v\n" +
+	"\t_venue_idB\x0e\n" +
+	"\f_operator_idB\f\n" +
+	"\n" +
+	"_parent_id\"?\n" +
+	"\x13CreateFieldResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xf8\x03\n" +
+	"\x12UpdateFieldRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x03 \x01(\tH\x01R\x04type\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x12\x19\n" +
+	"\x05price\x18\x05 \x01(\x04H\x03R\x05price\x88\x01\x01\x12\x1e\n" +
+	"\bvenue_id\x18\x06 \x01(\rH\x04R\avenueId\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\a \x01(\rH\x05R\bparentId\x88\x01\x01\x12\x1f\n" +
+	"\btimezone\x18\b \x01(\tH\x06R\btimezone\x88\x01\x01\x12&\n" +
+	"\fmin_duration\x18\t \x01(\rH\aR\vminDuration\x88\x01\x01\x12&\n" +
+	"\fmax_duration\x18\n" +
+	" \x01(\rH\bR\vmaxDuration\x88\x01\x01\x12%\n" +
+	"\vgranularity\x18\v \x01(\rH\tR\vgranularity\x88\x01\x01B\a\n" +
+	"\x05_nameB\a\n" +
+	"\x05_typeB\x0e\n" +
+	"\f_descriptionB\b\n" +
+	"\x06_priceB\v\n" +
+	"\t_venue_idB\f\n" +
+	"\n" +
+	"_parent_idB\v\n" +
+	"\t_timezoneB\x0f\n" +
+	"\r_min_durationB\x0f\n" +
+	"\r_max_durationB\x0e\n" +
+	"\f_granularity\"*\n" +
+	"\x0eStatusResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"D\n" +
+	"\x18ListDeletedFieldsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"\xe6\x01\n" +
+	"\x19SearchAvailabilityRequest\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x02 \x01(\tR\aendDate\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12 \n" +
+	"\tmin_price\x18\x04 \x01(\x04H\x00R\bminPrice\x88\x01\x01\x12 \n" +
+	"\tmax_price\x18\x05 \x01(\x04H\x01R\bmaxPrice\x88\x01\x01\x12\x1b\n" +
+	"\tmin_slots\x18\x06 \x01(\rR\bminSlotsB\f\n" +
+	"\n" +
+	"_min_priceB\f\n" +
+	"\n" +
+	"_max_price\"E\n" +
+	"\x04Slot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"Z\n" +
+	"\x11FieldAvailability\x12\"\n" +
+	"\x05field\x18\x01 \x01(\v2\f.field.FieldR\x05field\x12!\n" +
+	"\x05slots\x18\x02 \x03(\v2\v.field.SlotR\x05slots\"J\n" +
+	"\x1aSearchAvailabilityResponse\x12,\n" +
+	"\x04data\x18\x01 \x03(\v2\x18.field.FieldAvailabilityR\x04data\"U\n" +
+	"\x13ImportFieldsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"f\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\rR\x03row\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12\x12\n" +
+	"\x04rule\x18\x03 \x01(\tR\x04rule\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"v\n" +
+	"\x14ImportFieldsResponse\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x01 \x01(\rR\ttotalRows\x12\x10\n" +
+	"\x03ids\x18\x02 \x03(\rR\x03ids\x12-\n" +
+	"\x06errors\x18\x03 \x03(\v2\x15.field.ImportRowErrorR\x06errors\"Y\n" +
+	"\x13ExportFieldsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06search\x18\x03 \x01(\tR\x06search\"*\n" +
+	"\x14ExportFieldsResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data2\xa9\x05\n" +
+	"\fFieldService\x12>\n" +
+	"\tGetFields\x12\x17.field.GetFieldsRequest\x1a\x18.field.GetFieldsResponse\x12.\n" +
+	"\bGetField\x12\t.field.Id\x1a\x17.field.GetFieldResponse\x12D\n" +
+	"\vCreateField\x12\x19.field.CreateFieldRequest\x1a\x1a.field.CreateFieldResponse\x12?\n" +
+	"\vUpdateField\x12\x19.field.UpdateFieldRequest\x1a\x15.field.StatusResponse\x12/\n" +
+	"\vDeleteField\x12\t.field.Id\x1a\x15.field.StatusResponse\x12Y\n" +
+	"\x12SearchAvailability\x12 .field.SearchAvailabilityRequest\x1a!.field.SearchAvailabilityResponse\x120\n" +
+	"\fRestoreField\x12\t.field.Id\x1a\x15.field.StatusResponse\x12N\n" +
+	"\x11ListDeletedFields\x12\x1f.field.ListDeletedFieldsRequest\x1a\x18.field.GetFieldsResponse\x12I\n" +
+	"\fImportFields\x12\x1a.field.ImportFieldsRequest\x1a\x1b.field.ImportFieldsResponse(\x01\x12I\n" +
+	"\fExportFields\x12\x1a.field.ExportFieldsRequest\x1a\x1b.field.ExportFieldsResponse0\x01B=Z;github.com/DevisArya/learn-microservices-protorepo/pb/fieldb\x06proto3"
+
+var (
+	file_field_field_proto_rawDescOnce sync.Once
+	file_field_field_proto_rawDescData []byte
+)
+
+func file_field_field_proto_rawDescGZIP() []byte {
+	file_field_field_proto_rawDescOnce.Do(func() {
+		file_field_field_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_field_field_proto_rawDesc), len(file_field_field_proto_rawDesc)))
+	})
+	return file_field_field_proto_rawDescData
+}
+
+var file_field_field_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_field_field_proto_goTypes = []any{
+	(*Id)(nil),                         // 0: field.Id
+	(*GetFieldsRequest)(nil),           // 1: field.GetFieldsRequest
+	(*Field)(nil),                      // 2: field.Field
+	(*GetFieldsResponse)(nil),          // 3: field.GetFieldsResponse
+	(*GetFieldResponse)(nil),           // 4: field.GetFieldResponse
+	(*CreateFieldRequest)(nil),         // 5: field.CreateFieldRequest
+	(*CreateFieldResponse)(nil),        // 6: field.CreateFieldResponse
+	(*UpdateFieldRequest)(nil),         // 7: field.UpdateFieldRequest
+	(*StatusResponse)(nil),             // 8: field.StatusResponse
+	(*ListDeletedFieldsRequest)(nil),   // 9: field.ListDeletedFieldsRequest
+	(*SearchAvailabilityRequest)(nil),  // 10: field.SearchAvailabilityRequest
+	(*Slot)(nil),                       // 11: field.Slot
+	(*FieldAvailability)(nil),          // 12: field.FieldAvailability
+	(*SearchAvailabilityResponse)(nil), // 13: field.SearchAvailabilityResponse
+	(*ImportFieldsRequest)(nil),        // 14: field.ImportFieldsRequest
+	(*ImportRowError)(nil),             // 15: field.ImportRowError
+	(*ImportFieldsResponse)(nil),       // 16: field.ImportFieldsResponse
+	(*ExportFieldsRequest)(nil),        // 17: field.ExportFieldsRequest
+	(*ExportFieldsResponse)(nil),       // 18: field.ExportFieldsResponse
+	(*pagination.Pagination)(nil),      // 19: pagination.Pagination
+}
+var file_field_field_proto_depIdxs = []int32{
+	19, // 0: field.GetFieldsResponse.pagination:type_name -> pagination.Pagination
+	2,  // 1: field.GetFieldsResponse.data:type_name -> field.Field
+	2,  // 2: field.GetFieldResponse.field:type_name -> field.Field
+	2,  // 3: field.FieldAvailability.field:type_name -> field.Field
+	11, // 4: field.FieldAvailability.slots:type_name -> field.Slot
+	12, // 5: field.SearchAvailabilityResponse.data:type_name -> field.FieldAvailability
+	15, // 6: field.ImportFieldsResponse.errors:type_name -> field.ImportRowError
+	1,  // 7: field.FieldService.GetFields:input_type -> field.GetFieldsRequest
+	0,  // 8: field.FieldService.GetField:input_type -> field.Id
+	5,  // 9: field.FieldService.CreateField:input_type -> field.CreateFieldRequest
+	7,  // 10: field.FieldService.UpdateField:input_type -> field.UpdateFieldRequest
+	0,  // 11: field.FieldService.DeleteField:input_type -> field.Id
+	10, // 12: field.FieldService.SearchAvailability:input_type -> field.SearchAvailabilityRequest
+	0,  // 13: field.FieldService.RestoreField:input_type -> field.Id
+	9,  // 14: field.FieldService.ListDeletedFields:input_type -> field.ListDeletedFieldsRequest
+	14, // 15: field.FieldService.ImportFields:input_type -> field.ImportFieldsRequest
+	17, // 16: field.FieldService.ExportFields:input_type -> field.ExportFieldsRequest
+	3,  // 17: field.FieldService.GetFields:output_type -> field.GetFieldsResponse
+	4,  // 18: field.FieldService.GetField:output_type -> field.GetFieldResponse
+	6,  // 19: field.FieldService.CreateField:output_type -> field.CreateFieldResponse
+	8,  // 20: field.FieldService.UpdateField:output_type -> field.StatusResponse
+	8,  // 21: field.FieldService.DeleteField:output_type -> field.StatusResponse
+	13, // 22: field.FieldService.SearchAvailability:output_type -> field.SearchAvailabilityResponse
+	8,  // 23: field.FieldService.RestoreField:output_type -> field.StatusResponse
+	3,  // 24: field.FieldService.ListDeletedFields:output_type -> field.GetFieldsResponse
+	16, // 25: field.FieldService.ImportFields:output_type -> field.ImportFieldsResponse
+	18, // 26: field.FieldService.ExportFields:output_type -> field.ExportFieldsResponse
+	17, // [17:27] is the sub-list for method output_type
+	7,  // [7:17] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_field_field_proto_init() }
+func file_field_field_proto_init() {
+	if File_field_field_proto != nil {
+		return
+	}
+	file_field_field_proto_msgTypes[1].OneofWrappers = []any{}
+	file_field_field_proto_msgTypes[5].OneofWrappers = []any{}
+	file_field_field_proto_msgTypes[7].OneofWrappers = []any{}
+	file_field_field_proto_msgTypes[10].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_field_field_proto_rawDesc), len(file_field_field_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_field_field_proto_goTypes,
+		DependencyIndexes: file_field_field_proto_depIdxs,
+		MessageInfos:      file_field_field_proto_msgTypes,
+	}.Build()
+	File_field_field_proto = out.File
+	file_field_field_proto_goTypes = nil
+	file_field_field_proto_depIdxs = nil
+}
@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: pagination/pagination.proto
+
+package pagination
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPage   uint32                 `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	TotalRecord   uint32                 `protobuf:"varint,3,opt,name=total_record,json=totalRecord,proto3" json:"total_record,omitempty"`
+	TotalPage     uint32                 `protobuf:"varint,4,opt,name=total_page,json=totalPage,proto3" json:"total_page,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	PrevCursor    string                 `protobuf:"bytes,6,opt,name=prev_cursor,json=prevCursor,proto3" json:"prev_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_pagination_pagination_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_pagination_pagination_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_pagination_pagination_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Pagination) GetCurrentPage() uint32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *Pagination) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotalRecord() uint32 {
+	if x != nil {
+		return x.TotalRecord
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotalPage() uint32 {
+	if x != nil {
+		return x.TotalPage
+	}
+	return 0
+}
+
+func (x *Pagination) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *Pagination) GetPrevCursor() string {
+	if x != nil {
+		return x.PrevCursor
+	}
+	return ""
+}
+
+var File_pagination_pagination_proto protoreflect.FileDescriptor
+
+const file_pagination_pagination_proto_rawDesc = "" +
+	"\n" +
+	"\x1bpagination/pagination.proto\x12\n" +
+	"pagination\"\xc9\x01\n" +
+	"\n" +
+	"Pagination\x12!\n" +
+	"\fcurrent_page\x18\x01 \x01(\rR\vcurrentPage\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12!\n" +
+	"\ftotal_record\x18\x03 \x01(\rR\vtotalRecord\x12\x1d\n" +
+	"\n" +
+	"total_page\x18\x04 \x01(\rR\ttotalPage\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\x12\x1f\n" +
+	"\vprev_cursor\x18\x06 \x01(\tR\n" +
+	"prevCursorBBZ@github.com/DevisArya/learn-microservices-protorepo/pb/paginationb\x06proto3"
+
+var (
+	file_pagination_pagination_proto_rawDescOnce sync.Once
+	file_pagination_pagination_proto_rawDescData []byte
+)
+
+func file_pagination_pagination_proto_rawDescGZIP() []byte {
+	file_pagination_pagination_proto_rawDescOnce.Do(func() {
+		file_pagination_pagination_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pagination_pagination_proto_rawDesc), len(file_pagination_pagination_proto_rawDesc)))
+	})
+	return file_pagination_pagination_proto_rawDescData
+}
+
+var file_pagination_pagination_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pagination_pagination_proto_goTypes = []any{
+	(*Pagination)(nil), // 0: pagination.Pagination
+}
+var file_pagination_pagination_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_pagination_pagination_proto_init() }
+func file_pagination_pagination_proto_init() {
+	if File_pagination_pagination_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pagination_pagination_proto_rawDesc), len(file_pagination_pagination_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pagination_pagination_proto_goTypes,
+		DependencyIndexes: file_pagination_pagination_proto_depIdxs,
+		MessageInfos:      file_pagination_pagination_proto_msgTypes,
+	}.Build()
+	File_pagination_pagination_proto = out.File
+	file_pagination_pagination_proto_goTypes = nil
+	file_pagination_pagination_proto_depIdxs = nil
+}
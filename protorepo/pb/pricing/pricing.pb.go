@@ -0,0 +1,865 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: pricing/pricing.proto
+
+package pricing
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Id struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Id) Reset() {
+	*x = Id{}
+	mi := &file_pricing_pricing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Id) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Id) ProtoMessage() {}
+
+func (x *Id) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Id.ProtoReflect.Descriptor instead.
+func (*Id) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Id) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PricingRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FieldId       uint32                 `protobuf:"varint,2,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Weekdays      []uint32               `protobuf:"varint,5,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	StartTime     string                 `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ValidFrom     string                 `protobuf:"bytes,8,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidUntil    string                 `protobuf:"bytes,9,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	Percent       int32                  `protobuf:"varint,10,opt,name=percent,proto3" json:"percent,omitempty"`
+	Price         uint64                 `protobuf:"varint,11,opt,name=price,proto3" json:"price,omitempty"`
+	Priority      int32                  `protobuf:"varint,12,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricingRule) Reset() {
+	*x = PricingRule{}
+	mi := &file_pricing_pricing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingRule) ProtoMessage() {}
+
+func (x *PricingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingRule.ProtoReflect.Descriptor instead.
+func (*PricingRule) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PricingRule) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PricingRule) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *PricingRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PricingRule) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PricingRule) GetWeekdays() []uint32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *PricingRule) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *PricingRule) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *PricingRule) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *PricingRule) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+func (x *PricingRule) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *PricingRule) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PricingRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type GetPricingRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       uint32                 `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPricingRulesRequest) Reset() {
+	*x = GetPricingRulesRequest{}
+	mi := &file_pricing_pricing_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPricingRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPricingRulesRequest) ProtoMessage() {}
+
+func (x *GetPricingRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPricingRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetPricingRulesRequest) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPricingRulesRequest) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+type GetPricingRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*PricingRule         `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPricingRulesResponse) Reset() {
+	*x = GetPricingRulesResponse{}
+	mi := &file_pricing_pricing_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPricingRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPricingRulesResponse) ProtoMessage() {}
+
+func (x *GetPricingRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPricingRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetPricingRulesResponse) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPricingRulesResponse) GetData() []*PricingRule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreatePricingRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       uint32                 `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Weekdays      []uint32               `protobuf:"varint,4,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	StartTime     string                 `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       string                 `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ValidFrom     *string                `protobuf:"bytes,7,opt,name=valid_from,json=validFrom,proto3,oneof" json:"valid_from,omitempty"`
+	ValidUntil    *string                `protobuf:"bytes,8,opt,name=valid_until,json=validUntil,proto3,oneof" json:"valid_until,omitempty"`
+	Percent       int32                  `protobuf:"varint,9,opt,name=percent,proto3" json:"percent,omitempty"`
+	Price         uint64                 `protobuf:"varint,10,opt,name=price,proto3" json:"price,omitempty"`
+	Priority      int32                  `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePricingRuleRequest) Reset() {
+	*x = CreatePricingRuleRequest{}
+	mi := &file_pricing_pricing_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePricingRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePricingRuleRequest) ProtoMessage() {}
+
+func (x *CreatePricingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePricingRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreatePricingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreatePricingRuleRequest) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *CreatePricingRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePricingRuleRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreatePricingRuleRequest) GetWeekdays() []uint32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *CreatePricingRuleRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreatePricingRuleRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *CreatePricingRuleRequest) GetValidFrom() string {
+	if x != nil && x.ValidFrom != nil {
+		return *x.ValidFrom
+	}
+	return ""
+}
+
+func (x *CreatePricingRuleRequest) GetValidUntil() string {
+	if x != nil && x.ValidUntil != nil {
+		return *x.ValidUntil
+	}
+	return ""
+}
+
+func (x *CreatePricingRuleRequest) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *CreatePricingRuleRequest) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreatePricingRuleRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type CreatePricingRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *Id                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePricingRuleResponse) Reset() {
+	*x = CreatePricingRuleResponse{}
+	mi := &file_pricing_pricing_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePricingRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePricingRuleResponse) ProtoMessage() {}
+
+func (x *CreatePricingRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePricingRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreatePricingRuleResponse) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreatePricingRuleResponse) GetId() *Id {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type QuotePriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleIds   []uint32               `protobuf:"varint,1,rep,packed,name=schedule_ids,json=scheduleIds,proto3" json:"schedule_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotePriceRequest) Reset() {
+	*x = QuotePriceRequest{}
+	mi := &file_pricing_pricing_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePriceRequest) ProtoMessage() {}
+
+func (x *QuotePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePriceRequest.ProtoReflect.Descriptor instead.
+func (*QuotePriceRequest) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QuotePriceRequest) GetScheduleIds() []uint32 {
+	if x != nil {
+		return x.ScheduleIds
+	}
+	return nil
+}
+
+type PriceLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceLine) Reset() {
+	*x = PriceLine{}
+	mi := &file_pricing_pricing_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLine) ProtoMessage() {}
+
+func (x *PriceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLine.ProtoReflect.Descriptor instead.
+func (*PriceLine) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PriceLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PriceLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type SlotQuote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    uint32                 `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	FieldId       uint32                 `protobuf:"varint,2,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Lines         []*PriceLine           `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	Total         uint64                 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotQuote) Reset() {
+	*x = SlotQuote{}
+	mi := &file_pricing_pricing_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotQuote) ProtoMessage() {}
+
+func (x *SlotQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotQuote.ProtoReflect.Descriptor instead.
+func (*SlotQuote) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SlotQuote) GetScheduleId() uint32 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *SlotQuote) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *SlotQuote) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SlotQuote) GetLines() []*PriceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *SlotQuote) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type QuotePriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*SlotQuote           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Total         uint64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotePriceResponse) Reset() {
+	*x = QuotePriceResponse{}
+	mi := &file_pricing_pricing_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotePriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePriceResponse) ProtoMessage() {}
+
+func (x *QuotePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePriceResponse.ProtoReflect.Descriptor instead.
+func (*QuotePriceResponse) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QuotePriceResponse) GetData() []*SlotQuote {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *QuotePriceResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	mi := &file_pricing_pricing_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pricing_pricing_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_pricing_pricing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_pricing_pricing_proto protoreflect.FileDescriptor
+
+const file_pricing_pricing_proto_rawDesc = "" +
+	"\n" +
+	"\x15pricing/pricing.proto\x12\apricing\"\x14\n" +
+	"\x02Id\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\xc2\x02\n" +
+	"\vPricingRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
+	"\bfield_id\x18\x02 \x01(\rR\afieldId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bweekdays\x18\x05 \x03(\rR\bweekdays\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\a \x01(\tR\aendTime\x12\x1d\n" +
+	"\n" +
+	"valid_from\x18\b \x01(\tR\tvalidFrom\x12\x1f\n" +
+	"\vvalid_until\x18\t \x01(\tR\n" +
+	"validUntil\x12\x18\n" +
+	"\apercent\x18\n" +
+	" \x01(\x05R\apercent\x12\x14\n" +
+	"\x05price\x18\v \x01(\x04R\x05price\x12\x1a\n" +
+	"\bpriority\x18\f \x01(\x05R\bpriority\"3\n" +
+	"\x16GetPricingRulesRequest\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\rR\afieldId\"C\n" +
+	"\x17GetPricingRulesResponse\x12(\n" +
+	"\x04data\x18\x01 \x03(\v2\x14.pricing.PricingRuleR\x04data\"\xe8\x02\n" +
+	"\x18CreatePricingRuleRequest\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\rR\afieldId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bweekdays\x18\x04 \x03(\rR\bweekdays\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x06 \x01(\tR\aendTime\x12\"\n" +
+	"\n" +
+	"valid_from\x18\a \x01(\tH\x00R\tvalidFrom\x88\x01\x01\x12$\n" +
+	"\vvalid_until\x18\b \x01(\tH\x01R\n" +
+	"validUntil\x88\x01\x01\x12\x18\n" +
+	"\apercent\x18\t \x01(\x05R\apercent\x12\x14\n" +
+	"\x05price\x18\n" +
+	" \x01(\x04R\x05price\x12\x1a\n" +
+	"\bpriority\x18\v \x01(\x05R\bpriorityB\r\n" +
+	"\v_valid_fromB\x0e\n" +
+	"\f_valid_until\"8\n" +
+	"\x19CreatePricingRuleResponse\x12\x1b\n" +
+	"\x02id\x18\x01 \x01(\v2\v.pricing.IdR\x02id\"6\n" +
+	"\x11QuotePriceRequest\x12!\n" +
+	"\fschedule_ids\x18\x01 \x03(\rR\vscheduleIds\"E\n" +
+	"\tPriceLine\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"\x9b\x01\n" +
+	"\tSlotQuote\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\rR\n" +
+	"scheduleId\x12\x19\n" +
+	"\bfield_id\x18\x02 \x01(\rR\afieldId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12(\n" +
+	"\x05lines\x18\x04 \x03(\v2\x12.pricing.PriceLineR\x05lines\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x04R\x05total\"R\n" +
+	"\x12QuotePriceResponse\x12&\n" +
+	"\x04data\x18\x01 \x03(\v2\x12.pricing.SlotQuoteR\x04data\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"*\n" +
+	"\x0eStatusResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\xc4\x02\n" +
+	"\x0ePricingService\x12T\n" +
+	"\x0fGetPricingRules\x12\x1f.pricing.GetPricingRulesRequest\x1a .pricing.GetPricingRulesResponse\x12Z\n" +
+	"\x11CreatePricingRule\x12!.pricing.CreatePricingRuleRequest\x1a\".pricing.CreatePricingRuleResponse\x129\n" +
+	"\x11DeletePricingRule\x12\v.pricing.Id\x1a\x17.pricing.StatusResponse\x12E\n" +
+	"\n" +
+	"QuotePrice\x12\x1a.pricing.QuotePriceRequest\x1a\x1b.pricing.QuotePriceResponseB?Z=github.com/DevisArya/learn-microservices-protorepo/pb/pricingb\x06proto3"
+
+var (
+	file_pricing_pricing_proto_rawDescOnce sync.Once
+	file_pricing_pricing_proto_rawDescData []byte
+)
+
+func file_pricing_pricing_proto_rawDescGZIP() []byte {
+	file_pricing_pricing_proto_rawDescOnce.Do(func() {
+		file_pricing_pricing_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pricing_pricing_proto_rawDesc), len(file_pricing_pricing_proto_rawDesc)))
+	})
+	return file_pricing_pricing_proto_rawDescData
+}
+
+var file_pricing_pricing_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_pricing_pricing_proto_goTypes = []any{
+	(*Id)(nil),                        // 0: pricing.Id
+	(*PricingRule)(nil),               // 1: pricing.PricingRule
+	(*GetPricingRulesRequest)(nil),    // 2: pricing.GetPricingRulesRequest
+	(*GetPricingRulesResponse)(nil),   // 3: pricing.GetPricingRulesResponse
+	(*CreatePricingRuleRequest)(nil),  // 4: pricing.CreatePricingRuleRequest
+	(*CreatePricingRuleResponse)(nil), // 5: pricing.CreatePricingRuleResponse
+	(*QuotePriceRequest)(nil),         // 6: pricing.QuotePriceRequest
+	(*PriceLine)(nil),                 // 7: pricing.PriceLine
+	(*SlotQuote)(nil),                 // 8: pricing.SlotQuote
+	(*QuotePriceResponse)(nil),        // 9: pricing.QuotePriceResponse
+	(*StatusResponse)(nil),            // 10: pricing.StatusResponse
+}
+var file_pricing_pricing_proto_depIdxs = []int32{
+	1,  // 0: pricing.GetPricingRulesResponse.data:type_name -> pricing.PricingRule
+	0,  // 1: pricing.CreatePricingRuleResponse.id:type_name -> pricing.Id
+	7,  // 2: pricing.SlotQuote.lines:type_name -> pricing.PriceLine
+	8,  // 3: pricing.QuotePriceResponse.data:type_name -> pricing.SlotQuote
+	2,  // 4: pricing.PricingService.GetPricingRules:input_type -> pricing.GetPricingRulesRequest
+	4,  // 5: pricing.PricingService.CreatePricingRule:input_type -> pricing.CreatePricingRuleRequest
+	0,  // 6: pricing.PricingService.DeletePricingRule:input_type -> pricing.Id
+	6,  // 7: pricing.PricingService.QuotePrice:input_type -> pricing.QuotePriceRequest
+	3,  // 8: pricing.PricingService.GetPricingRules:output_type -> pricing.GetPricingRulesResponse
+	5,  // 9: pricing.PricingService.CreatePricingRule:output_type -> pricing.CreatePricingRuleResponse
+	10, // 10: pricing.PricingService.DeletePricingRule:output_type -> pricing.StatusResponse
+	9,  // 11: pricing.PricingService.QuotePrice:output_type -> pricing.QuotePriceResponse
+	8,  // [8:12] is the sub-list for method output_type
+	4,  // [4:8] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_pricing_pricing_proto_init() }
+func file_pricing_pricing_proto_init() {
+	if File_pricing_pricing_proto != nil {
+		return
+	}
+	file_pricing_pricing_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pricing_pricing_proto_rawDesc), len(file_pricing_pricing_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pricing_pricing_proto_goTypes,
+		DependencyIndexes: file_pricing_pricing_proto_depIdxs,
+		MessageInfos:      file_pricing_pricing_proto_msgTypes,
+	}.Build()
+	File_pricing_pricing_proto = out.File
+	file_pricing_pricing_proto_goTypes = nil
+	file_pricing_pricing_proto_depIdxs = nil
+}
@@ -0,0 +1,235 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: pricing/pricing.proto
+
+package pricing
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PricingService_GetPricingRules_FullMethodName   = "/pricing.PricingService/GetPricingRules"
+	PricingService_CreatePricingRule_FullMethodName = "/pricing.PricingService/CreatePricingRule"
+	PricingService_DeletePricingRule_FullMethodName = "/pricing.PricingService/DeletePricingRule"
+	PricingService_QuotePrice_FullMethodName        = "/pricing.PricingService/QuotePrice"
+)
+
+// PricingServiceClient is the client API for PricingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PricingServiceClient interface {
+	GetPricingRules(ctx context.Context, in *GetPricingRulesRequest, opts ...grpc.CallOption) (*GetPricingRulesResponse, error)
+	CreatePricingRule(ctx context.Context, in *CreatePricingRuleRequest, opts ...grpc.CallOption) (*CreatePricingRuleResponse, error)
+	DeletePricingRule(ctx context.Context, in *Id, opts ...grpc.CallOption) (*StatusResponse, error)
+	QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*QuotePriceResponse, error)
+}
+
+type pricingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPricingServiceClient(cc grpc.ClientConnInterface) PricingServiceClient {
+	return &pricingServiceClient{cc}
+}
+
+func (c *pricingServiceClient) GetPricingRules(ctx context.Context, in *GetPricingRulesRequest, opts ...grpc.CallOption) (*GetPricingRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPricingRulesResponse)
+	err := c.cc.Invoke(ctx, PricingService_GetPricingRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pricingServiceClient) CreatePricingRule(ctx context.Context, in *CreatePricingRuleRequest, opts ...grpc.CallOption) (*CreatePricingRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePricingRuleResponse)
+	err := c.cc.Invoke(ctx, PricingService_CreatePricingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pricingServiceClient) DeletePricingRule(ctx context.Context, in *Id, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, PricingService_DeletePricingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pricingServiceClient) QuotePrice(ctx context.Context, in *QuotePriceRequest, opts ...grpc.CallOption) (*QuotePriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotePriceResponse)
+	err := c.cc.Invoke(ctx, PricingService_QuotePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PricingServiceServer is the server API for PricingService service.
+// All implementations must embed UnimplementedPricingServiceServer
+// for forward compatibility.
+type PricingServiceServer interface {
+	GetPricingRules(context.Context, *GetPricingRulesRequest) (*GetPricingRulesResponse, error)
+	CreatePricingRule(context.Context, *CreatePricingRuleRequest) (*CreatePricingRuleResponse, error)
+	DeletePricingRule(context.Context, *Id) (*StatusResponse, error)
+	QuotePrice(context.Context, *QuotePriceRequest) (*QuotePriceResponse, error)
+	mustEmbedUnimplementedPricingServiceServer()
+}
+
+// UnimplementedPricingServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPricingServiceServer struct{}
+
+func (UnimplementedPricingServiceServer) GetPricingRules(context.Context, *GetPricingRulesRequest) (*GetPricingRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPricingRules not implemented")
+}
+func (UnimplementedPricingServiceServer) CreatePricingRule(context.Context, *CreatePricingRuleRequest) (*CreatePricingRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePricingRule not implemented")
+}
+func (UnimplementedPricingServiceServer) DeletePricingRule(context.Context, *Id) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePricingRule not implemented")
+}
+func (UnimplementedPricingServiceServer) QuotePrice(context.Context, *QuotePriceRequest) (*QuotePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotePrice not implemented")
+}
+func (UnimplementedPricingServiceServer) mustEmbedUnimplementedPricingServiceServer() {}
+func (UnimplementedPricingServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePricingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PricingServiceServer will
+// result in compilation errors.
+type UnsafePricingServiceServer interface {
+	mustEmbedUnimplementedPricingServiceServer()
+}
+
+func RegisterPricingServiceServer(s grpc.ServiceRegistrar, srv PricingServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPricingServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PricingService_ServiceDesc, srv)
+}
+
+func _PricingService_GetPricingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPricingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PricingServiceServer).GetPricingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PricingService_GetPricingRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PricingServiceServer).GetPricingRules(ctx, req.(*GetPricingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PricingService_CreatePricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePricingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PricingServiceServer).CreatePricingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PricingService_CreatePricingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PricingServiceServer).CreatePricingRule(ctx, req.(*CreatePricingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PricingService_DeletePricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PricingServiceServer).DeletePricingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PricingService_DeletePricingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PricingServiceServer).DeletePricingRule(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PricingService_QuotePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PricingServiceServer).QuotePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PricingService_QuotePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PricingServiceServer).QuotePrice(ctx, req.(*QuotePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PricingService_ServiceDesc is the grpc.ServiceDesc for PricingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PricingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pricing.PricingService",
+	HandlerType: (*PricingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPricingRules",
+			Handler:    _PricingService_GetPricingRules_Handler,
+		},
+		{
+			MethodName: "CreatePricingRule",
+			Handler:    _PricingService_CreatePricingRule_Handler,
+		},
+		{
+			MethodName: "DeletePricingRule",
+			Handler:    _PricingService_DeletePricingRule_Handler,
+		},
+		{
+			MethodName: "QuotePrice",
+			Handler:    _PricingService_QuotePrice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pricing/pricing.proto",
+}
@@ -0,0 +1,1576 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: schedule/schedule.proto
+
+package schedule
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Schedule times are RFC 3339 in the field time zone named by timezone. A
+// schedule lasts from date (inclusive) to end_date (exclusive).
+// Requests accept RFC 3339, or a wall clock time without offset
+// ("2006-01-02T15:04:05") read in the field time zone.
+type Schedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FieldId       uint32                 `protobuf:"varint,3,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	HoldExpiresAt string                 `protobuf:"bytes,6,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"`
+	Timezone      string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	EndDate       string                 `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_schedule_schedule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Schedule) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Schedule) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Schedule) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *Schedule) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Schedule) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Schedule) GetHoldExpiresAt() string {
+	if x != nil {
+		return x.HoldExpiresAt
+	}
+	return ""
+}
+
+func (x *Schedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Schedule) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type Id struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Id) Reset() {
+	*x = Id{}
+	mi := &file_schedule_schedule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Id) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Id) ProtoMessage() {}
+
+func (x *Id) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Id.ProtoReflect.Descriptor instead.
+func (*Id) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Id) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleResponse) Reset() {
+	*x = GetScheduleResponse{}
+	mi := &file_schedule_schedule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleResponse) ProtoMessage() {}
+
+func (x *GetScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type GetSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       uint32                 `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSchedulesRequest) Reset() {
+	*x = GetSchedulesRequest{}
+	mi := &file_schedule_schedule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulesRequest) ProtoMessage() {}
+
+func (x *GetSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*GetSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetSchedulesRequest) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *GetSchedulesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetSchedulesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type GetSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Schedule            `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSchedulesResponse) Reset() {
+	*x = GetSchedulesResponse{}
+	mi := &file_schedule_schedule_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSchedulesResponse) ProtoMessage() {}
+
+func (x *GetSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*GetSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetSchedulesResponse) GetData() []*Schedule {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       uint32                 `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleRequest) Reset() {
+	*x = CreateScheduleRequest{}
+	mi := &file_schedule_schedule_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleRequest) ProtoMessage() {}
+
+func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateScheduleRequest) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *CreateScheduleRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type CreateScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *Id                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleResponse) Reset() {
+	*x = CreateScheduleResponse{}
+	mi := &file_schedule_schedule_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleResponse) ProtoMessage() {}
+
+func (x *CreateScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateScheduleResponse) GetId() *Id {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type UpdateScheduleStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *Id                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	UserId        *uint32                `protobuf:"varint,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduleStatusRequest) Reset() {
+	*x = UpdateScheduleStatusRequest{}
+	mi := &file_schedule_schedule_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduleStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduleStatusRequest) ProtoMessage() {}
+
+func (x *UpdateScheduleStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduleStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduleStatusRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateScheduleStatusRequest) GetId() *Id {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UpdateScheduleStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateScheduleStatusRequest) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+type ExtendHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HoldExpiresAt string                 `protobuf:"bytes,1,opt,name=hold_expires_at,json=holdExpiresAt,proto3" json:"hold_expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendHoldResponse) Reset() {
+	*x = ExtendHoldResponse{}
+	mi := &file_schedule_schedule_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendHoldResponse) ProtoMessage() {}
+
+func (x *ExtendHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendHoldResponse.ProtoReflect.Descriptor instead.
+func (*ExtendHoldResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExtendHoldResponse) GetHoldExpiresAt() string {
+	if x != nil {
+		return x.HoldExpiresAt
+	}
+	return ""
+}
+
+// WatchSchedulesRequest resumes after the event of resume_token when set,
+// replaying the changes missed in between.
+type WatchSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       uint32                 `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSchedulesRequest) Reset() {
+	*x = WatchSchedulesRequest{}
+	mi := &file_schedule_schedule_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSchedulesRequest) ProtoMessage() {}
+
+func (x *WatchSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*WatchSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchSchedulesRequest) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *WatchSchedulesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *WatchSchedulesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *WatchSchedulesRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type ScheduleEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ResumeToken    string                 `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	Schedule       *Schedule              `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	PreviousStatus string                 `protobuf:"bytes,3,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	OccurredAt     string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScheduleEvent) Reset() {
+	*x = ScheduleEvent{}
+	mi := &file_schedule_schedule_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleEvent) ProtoMessage() {}
+
+func (x *ScheduleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleEvent.ProtoReflect.Descriptor instead.
+func (*ScheduleEvent) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ScheduleEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *ScheduleEvent) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *ScheduleEvent) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *ScheduleEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type ScheduleTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FieldId       uint32                 `protobuf:"varint,2,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Weekday       uint32                 `protobuf:"varint,3,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	OpenTime      string                 `protobuf:"bytes,4,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime     string                 `protobuf:"bytes,5,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	SlotDuration  uint32                 `protobuf:"varint,6,opt,name=slot_duration,json=slotDuration,proto3" json:"slot_duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleTemplate) Reset() {
+	*x = ScheduleTemplate{}
+	mi := &file_schedule_schedule_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleTemplate) ProtoMessage() {}
+
+func (x *ScheduleTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleTemplate.ProtoReflect.Descriptor instead.
+func (*ScheduleTemplate) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ScheduleTemplate) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduleTemplate) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *ScheduleTemplate) GetWeekday() uint32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *ScheduleTemplate) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *ScheduleTemplate) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+func (x *ScheduleTemplate) GetSlotDuration() uint32 {
+	if x != nil {
+		return x.SlotDuration
+	}
+	return 0
+}
+
+type GetScheduleTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       uint32                 `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleTemplatesRequest) Reset() {
+	*x = GetScheduleTemplatesRequest{}
+	mi := &file_schedule_schedule_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleTemplatesRequest) ProtoMessage() {}
+
+func (x *GetScheduleTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetScheduleTemplatesRequest) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+type GetScheduleTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*ScheduleTemplate    `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleTemplatesResponse) Reset() {
+	*x = GetScheduleTemplatesResponse{}
+	mi := &file_schedule_schedule_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleTemplatesResponse) ProtoMessage() {}
+
+func (x *GetScheduleTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetScheduleTemplatesResponse) GetData() []*ScheduleTemplate {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateScheduleTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       uint32                 `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	Weekday       uint32                 `protobuf:"varint,2,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	OpenTime      string                 `protobuf:"bytes,3,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime     string                 `protobuf:"bytes,4,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	SlotDuration  uint32                 `protobuf:"varint,5,opt,name=slot_duration,json=slotDuration,proto3" json:"slot_duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleTemplateRequest) Reset() {
+	*x = CreateScheduleTemplateRequest{}
+	mi := &file_schedule_schedule_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleTemplateRequest) ProtoMessage() {}
+
+func (x *CreateScheduleTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateScheduleTemplateRequest) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *CreateScheduleTemplateRequest) GetWeekday() uint32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *CreateScheduleTemplateRequest) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *CreateScheduleTemplateRequest) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+func (x *CreateScheduleTemplateRequest) GetSlotDuration() uint32 {
+	if x != nil {
+		return x.SlotDuration
+	}
+	return 0
+}
+
+type CreateScheduleTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *Id                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScheduleTemplateResponse) Reset() {
+	*x = CreateScheduleTemplateResponse{}
+	mi := &file_schedule_schedule_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleTemplateResponse) ProtoMessage() {}
+
+func (x *CreateScheduleTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateScheduleTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateScheduleTemplateResponse) GetId() *Id {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type GenerateSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weeks         uint32                 `protobuf:"varint,1,opt,name=weeks,proto3" json:"weeks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSchedulesRequest) Reset() {
+	*x = GenerateSchedulesRequest{}
+	mi := &file_schedule_schedule_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSchedulesRequest) ProtoMessage() {}
+
+func (x *GenerateSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*GenerateSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GenerateSchedulesRequest) GetWeeks() uint32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+type Blackout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FieldId       uint32                 `protobuf:"varint,2,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	StartAt       string                 `protobuf:"bytes,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         string                 `protobuf:"bytes,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Blackout) Reset() {
+	*x = Blackout{}
+	mi := &file_schedule_schedule_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Blackout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Blackout) ProtoMessage() {}
+
+func (x *Blackout) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Blackout.ProtoReflect.Descriptor instead.
+func (*Blackout) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Blackout) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Blackout) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *Blackout) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *Blackout) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *Blackout) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetBlackoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       uint32                 `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	StartDate     string                 `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlackoutsRequest) Reset() {
+	*x = GetBlackoutsRequest{}
+	mi := &file_schedule_schedule_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlackoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlackoutsRequest) ProtoMessage() {}
+
+func (x *GetBlackoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlackoutsRequest.ProtoReflect.Descriptor instead.
+func (*GetBlackoutsRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetBlackoutsRequest) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *GetBlackoutsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetBlackoutsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type GetBlackoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*Blackout            `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlackoutsResponse) Reset() {
+	*x = GetBlackoutsResponse{}
+	mi := &file_schedule_schedule_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlackoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlackoutsResponse) ProtoMessage() {}
+
+func (x *GetBlackoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlackoutsResponse.ProtoReflect.Descriptor instead.
+func (*GetBlackoutsResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetBlackoutsResponse) GetData() []*Blackout {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateBlackoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldId       uint32                 `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	StartAt       string                 `protobuf:"bytes,2,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         string                 `protobuf:"bytes,3,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Force         bool                   `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBlackoutRequest) Reset() {
+	*x = CreateBlackoutRequest{}
+	mi := &file_schedule_schedule_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBlackoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlackoutRequest) ProtoMessage() {}
+
+func (x *CreateBlackoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlackoutRequest.ProtoReflect.Descriptor instead.
+func (*CreateBlackoutRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateBlackoutRequest) GetFieldId() uint32 {
+	if x != nil {
+		return x.FieldId
+	}
+	return 0
+}
+
+func (x *CreateBlackoutRequest) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *CreateBlackoutRequest) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *CreateBlackoutRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateBlackoutRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type CreateBlackoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *Id                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Conflicts     []*Schedule            `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBlackoutResponse) Reset() {
+	*x = CreateBlackoutResponse{}
+	mi := &file_schedule_schedule_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBlackoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBlackoutResponse) ProtoMessage() {}
+
+func (x *CreateBlackoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBlackoutResponse.ProtoReflect.Descriptor instead.
+func (*CreateBlackoutResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateBlackoutResponse) GetId() *Id {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *CreateBlackoutResponse) GetConflicts() []*Schedule {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	mi := &file_schedule_schedule_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_schedule_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_schedule_schedule_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *StatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_schedule_schedule_proto protoreflect.FileDescriptor
+
+const file_schedule_schedule_proto_rawDesc = "" +
+	"\n" +
+	"\x17schedule/schedule.proto\x12\bschedule\"\xd9\x01\n" +
+	"\bSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x19\n" +
+	"\bfield_id\x18\x03 \x01(\rR\afieldId\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12&\n" +
+	"\x0fhold_expires_at\x18\x06 \x01(\tR\rholdExpiresAt\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\x12\x19\n" +
+	"\bend_date\x18\b \x01(\tR\aendDate\"\x14\n" +
+	"\x02Id\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"E\n" +
+	"\x13GetScheduleResponse\x12.\n" +
+	"\bschedule\x18\x01 \x01(\v2\x12.schedule.ScheduleR\bschedule\"j\n" +
+	"\x13GetSchedulesRequest\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\rR\afieldId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\">\n" +
+	"\x14GetSchedulesResponse\x12&\n" +
+	"\x04data\x18\x01 \x03(\v2\x12.schedule.ScheduleR\x04data\"a\n" +
+	"\x15CreateScheduleRequest\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\rR\afieldId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\"6\n" +
+	"\x16CreateScheduleResponse\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\v2\f.schedule.IdR\x02id\"}\n" +
+	"\x1bUpdateScheduleStatusRequest\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\v2\f.schedule.IdR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\rH\x00R\x06userId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_id\"<\n" +
+	"\x12ExtendHoldResponse\x12&\n" +
+	"\x0fhold_expires_at\x18\x01 \x01(\tR\rholdExpiresAt\"\x8f\x01\n" +
+	"\x15WatchSchedulesRequest\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\rR\afieldId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\"\xac\x01\n" +
+	"\rScheduleEvent\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\x12.\n" +
+	"\bschedule\x18\x02 \x01(\v2\x12.schedule.ScheduleR\bschedule\x12'\n" +
+	"\x0fprevious_status\x18\x03 \x01(\tR\x0epreviousStatus\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\"\xb8\x01\n" +
+	"\x10ScheduleTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
+	"\bfield_id\x18\x02 \x01(\rR\afieldId\x12\x18\n" +
+	"\aweekday\x18\x03 \x01(\rR\aweekday\x12\x1b\n" +
+	"\topen_time\x18\x04 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\n" +
+	"close_time\x18\x05 \x01(\tR\tcloseTime\x12#\n" +
+	"\rslot_duration\x18\x06 \x01(\rR\fslotDuration\"8\n" +
+	"\x1bGetScheduleTemplatesRequest\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\rR\afieldId\"N\n" +
+	"\x1cGetScheduleTemplatesResponse\x12.\n" +
+	"\x04data\x18\x01 \x03(\v2\x1a.schedule.ScheduleTemplateR\x04data\"\xb5\x01\n" +
+	"\x1dCreateScheduleTemplateRequest\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\rR\afieldId\x12\x18\n" +
+	"\aweekday\x18\x02 \x01(\rR\aweekday\x12\x1b\n" +
+	"\topen_time\x18\x03 \x01(\tR\bopenTime\x12\x1d\n" +
+	"\n" +
+	"close_time\x18\x04 \x01(\tR\tcloseTime\x12#\n" +
+	"\rslot_duration\x18\x05 \x01(\rR\fslotDuration\">\n" +
+	"\x1eCreateScheduleTemplateResponse\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\v2\f.schedule.IdR\x02id\"0\n" +
+	"\x18GenerateSchedulesRequest\x12\x14\n" +
+	"\x05weeks\x18\x01 \x01(\rR\x05weeks\"\x7f\n" +
+	"\bBlackout\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
+	"\bfield_id\x18\x02 \x01(\rR\afieldId\x12\x19\n" +
+	"\bstart_at\x18\x03 \x01(\tR\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x04 \x01(\tR\x05endAt\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"j\n" +
+	"\x13GetBlackoutsRequest\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\rR\afieldId\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x02 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x03 \x01(\tR\aendDate\">\n" +
+	"\x14GetBlackoutsResponse\x12&\n" +
+	"\x04data\x18\x01 \x03(\v2\x12.schedule.BlackoutR\x04data\"\x92\x01\n" +
+	"\x15CreateBlackoutRequest\x12\x19\n" +
+	"\bfield_id\x18\x01 \x01(\rR\afieldId\x12\x19\n" +
+	"\bstart_at\x18\x02 \x01(\tR\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x03 \x01(\tR\x05endAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05force\x18\x05 \x01(\bR\x05force\"h\n" +
+	"\x16CreateBlackoutResponse\x12\x1c\n" +
+	"\x02id\x18\x01 \x01(\v2\f.schedule.IdR\x02id\x120\n" +
+	"\tconflicts\x18\x02 \x03(\v2\x12.schedule.ScheduleR\tconflicts\"*\n" +
+	"\x0eStatusResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\xd3\b\n" +
+	"\x0fScheduleService\x12:\n" +
+	"\vGetSchedule\x12\f.schedule.Id\x1a\x1d.schedule.GetScheduleResponse\x12M\n" +
+	"\fGetSchedules\x12\x1d.schedule.GetSchedulesRequest\x1a\x1e.schedule.GetSchedulesResponse\x12S\n" +
+	"\x0eCreateSchedule\x12\x1f.schedule.CreateScheduleRequest\x1a .schedule.CreateScheduleResponse\x12W\n" +
+	"\x14UpdateScheduleStatus\x12%.schedule.UpdateScheduleStatusRequest\x1a\x18.schedule.StatusResponse\x128\n" +
+	"\x0eDeleteSchedule\x12\f.schedule.Id\x1a\x18.schedule.StatusResponse\x128\n" +
+	"\n" +
+	"ExtendHold\x12\f.schedule.Id\x1a\x1c.schedule.ExtendHoldResponse\x12L\n" +
+	"\x0eWatchSchedules\x12\x1f.schedule.WatchSchedulesRequest\x1a\x17.schedule.ScheduleEvent0\x01\x12e\n" +
+	"\x14GetScheduleTemplates\x12%.schedule.GetScheduleTemplatesRequest\x1a&.schedule.GetScheduleTemplatesResponse\x12k\n" +
+	"\x16CreateScheduleTemplate\x12'.schedule.CreateScheduleTemplateRequest\x1a(.schedule.CreateScheduleTemplateResponse\x12@\n" +
+	"\x16DeleteScheduleTemplate\x12\f.schedule.Id\x1a\x18.schedule.StatusResponse\x12Q\n" +
+	"\x11GenerateSchedules\x12\".schedule.GenerateSchedulesRequest\x1a\x18.schedule.StatusResponse\x12M\n" +
+	"\fGetBlackouts\x12\x1d.schedule.GetBlackoutsRequest\x1a\x1e.schedule.GetBlackoutsResponse\x12S\n" +
+	"\x0eCreateBlackout\x12\x1f.schedule.CreateBlackoutRequest\x1a .schedule.CreateBlackoutResponse\x128\n" +
+	"\x0eDeleteBlackout\x12\f.schedule.Id\x1a\x18.schedule.StatusResponseB@Z>github.com/DevisArya/learn-microservices-protorepo/pb/scheduleb\x06proto3"
+
+var (
+	file_schedule_schedule_proto_rawDescOnce sync.Once
+	file_schedule_schedule_proto_rawDescData []byte
+)
+
+func file_schedule_schedule_proto_rawDescGZIP() []byte {
+	file_schedule_schedule_proto_rawDescOnce.Do(func() {
+		file_schedule_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_schedule_schedule_proto_rawDesc), len(file_schedule_schedule_proto_rawDesc)))
+	})
+	return file_schedule_schedule_proto_rawDescData
+}
+
+var file_schedule_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_schedule_schedule_proto_goTypes = []any{
+	(*Schedule)(nil),                       // 0: schedule.Schedule
+	(*Id)(nil),                             // 1: schedule.Id
+	(*GetScheduleResponse)(nil),            // 2: schedule.GetScheduleResponse
+	(*GetSchedulesRequest)(nil),            // 3: schedule.GetSchedulesRequest
+	(*GetSchedulesResponse)(nil),           // 4: schedule.GetSchedulesResponse
+	(*CreateScheduleRequest)(nil),          // 5: schedule.CreateScheduleRequest
+	(*CreateScheduleResponse)(nil),         // 6: schedule.CreateScheduleResponse
+	(*UpdateScheduleStatusRequest)(nil),    // 7: schedule.UpdateScheduleStatusRequest
+	(*ExtendHoldResponse)(nil),             // 8: schedule.ExtendHoldResponse
+	(*WatchSchedulesRequest)(nil),          // 9: schedule.WatchSchedulesRequest
+	(*ScheduleEvent)(nil),                  // 10: schedule.ScheduleEvent
+	(*ScheduleTemplate)(nil),               // 11: schedule.ScheduleTemplate
+	(*GetScheduleTemplatesRequest)(nil),    // 12: schedule.GetScheduleTemplatesRequest
+	(*GetScheduleTemplatesResponse)(nil),   // 13: schedule.GetScheduleTemplatesResponse
+	(*CreateScheduleTemplateRequest)(nil),  // 14: schedule.CreateScheduleTemplateRequest
+	(*CreateScheduleTemplateResponse)(nil), // 15: schedule.CreateScheduleTemplateResponse
+	(*GenerateSchedulesRequest)(nil),       // 16: schedule.GenerateSchedulesRequest
+	(*Blackout)(nil),                       // 17: schedule.Blackout
+	(*GetBlackoutsRequest)(nil),            // 18: schedule.GetBlackoutsRequest
+	(*GetBlackoutsResponse)(nil),           // 19: schedule.GetBlackoutsResponse
+	(*CreateBlackoutRequest)(nil),          // 20: schedule.CreateBlackoutRequest
+	(*CreateBlackoutResponse)(nil),         // 21: schedule.CreateBlackoutResponse
+	(*StatusResponse)(nil),                 // 22: schedule.StatusResponse
+}
+var file_schedule_schedule_proto_depIdxs = []int32{
+	0,  // 0: schedule.GetScheduleResponse.schedule:type_name -> schedule.Schedule
+	0,  // 1: schedule.GetSchedulesResponse.data:type_name -> schedule.Schedule
+	1,  // 2: schedule.CreateScheduleResponse.id:type_name -> schedule.Id
+	1,  // 3: schedule.UpdateScheduleStatusRequest.id:type_name -> schedule.Id
+	0,  // 4: schedule.ScheduleEvent.schedule:type_name -> schedule.Schedule
+	11, // 5: schedule.GetScheduleTemplatesResponse.data:type_name -> schedule.ScheduleTemplate
+	1,  // 6: schedule.CreateScheduleTemplateResponse.id:type_name -> schedule.Id
+	17, // 7: schedule.GetBlackoutsResponse.data:type_name -> schedule.Blackout
+	1,  // 8: schedule.CreateBlackoutResponse.id:type_name -> schedule.Id
+	0,  // 9: schedule.CreateBlackoutResponse.conflicts:type_name -> schedule.Schedule
+	1,  // 10: schedule.ScheduleService.GetSchedule:input_type -> schedule.Id
+	3,  // 11: schedule.ScheduleService.GetSchedules:input_type -> schedule.GetSchedulesRequest
+	5,  // 12: schedule.ScheduleService.CreateSchedule:input_type -> schedule.CreateScheduleRequest
+	7,  // 13: schedule.ScheduleService.UpdateScheduleStatus:input_type -> schedule.UpdateScheduleStatusRequest
+	1,  // 14: schedule.ScheduleService.DeleteSchedule:input_type -> schedule.Id
+	1,  // 15: schedule.ScheduleService.ExtendHold:input_type -> schedule.Id
+	9,  // 16: schedule.ScheduleService.WatchSchedules:input_type -> schedule.WatchSchedulesRequest
+	12, // 17: schedule.ScheduleService.GetScheduleTemplates:input_type -> schedule.GetScheduleTemplatesRequest
+	14, // 18: schedule.ScheduleService.CreateScheduleTemplate:input_type -> schedule.CreateScheduleTemplateRequest
+	1,  // 19: schedule.ScheduleService.DeleteScheduleTemplate:input_type -> schedule.Id
+	16, // 20: schedule.ScheduleService.GenerateSchedules:input_type -> schedule.GenerateSchedulesRequest
+	18, // 21: schedule.ScheduleService.GetBlackouts:input_type -> schedule.GetBlackoutsRequest
+	20, // 22: schedule.ScheduleService.CreateBlackout:input_type -> schedule.CreateBlackoutRequest
+	1,  // 23: schedule.ScheduleService.DeleteBlackout:input_type -> schedule.Id
+	2,  // 24: schedule.ScheduleService.GetSchedule:output_type -> schedule.GetScheduleResponse
+	4,  // 25: schedule.ScheduleService.GetSchedules:output_type -> schedule.GetSchedulesResponse
+	6,  // 26: schedule.ScheduleService.CreateSchedule:output_type -> schedule.CreateScheduleResponse
+	22, // 27: schedule.ScheduleService.UpdateScheduleStatus:output_type -> schedule.StatusResponse
+	22, // 28: schedule.ScheduleService.DeleteSchedule:output_type -> schedule.StatusResponse
+	8,  // 29: schedule.ScheduleService.ExtendHold:output_type -> schedule.ExtendHoldResponse
+	10, // 30: schedule.ScheduleService.WatchSchedules:output_type -> schedule.ScheduleEvent
+	13, // 31: schedule.ScheduleService.GetScheduleTemplates:output_type -> schedule.GetScheduleTemplatesResponse
+	15, // 32: schedule.ScheduleService.CreateScheduleTemplate:output_type -> schedule.CreateScheduleTemplateResponse
+	22, // 33: schedule.ScheduleService.DeleteScheduleTemplate:output_type -> schedule.StatusResponse
+	22, // 34: schedule.ScheduleService.GenerateSchedules:output_type -> schedule.StatusResponse
+	19, // 35: schedule.ScheduleService.GetBlackouts:output_type -> schedule.GetBlackoutsResponse
+	21, // 36: schedule.ScheduleService.CreateBlackout:output_type -> schedule.CreateBlackoutResponse
+	22, // 37: schedule.ScheduleService.DeleteBlackout:output_type -> schedule.StatusResponse
+	24, // [24:38] is the sub-list for method output_type
+	10, // [10:24] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_schedule_schedule_proto_init() }
+func file_schedule_schedule_proto_init() {
+	if File_schedule_schedule_proto != nil {
+		return
+	}
+	file_schedule_schedule_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_schedule_schedule_proto_rawDesc), len(file_schedule_schedule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   23,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_schedule_schedule_proto_goTypes,
+		DependencyIndexes: file_schedule_schedule_proto_depIdxs,
+		MessageInfos:      file_schedule_schedule_proto_msgTypes,
+	}.Build()
+	File_schedule_schedule_proto = out.File
+	file_schedule_schedule_proto_goTypes = nil
+	file_schedule_schedule_proto_depIdxs = nil
+}
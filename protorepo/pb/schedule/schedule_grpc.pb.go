@@ -0,0 +1,619 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: schedule/schedule.proto
+
+package schedule
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ScheduleService_GetSchedule_FullMethodName            = "/schedule.ScheduleService/GetSchedule"
+	ScheduleService_GetSchedules_FullMethodName           = "/schedule.ScheduleService/GetSchedules"
+	ScheduleService_CreateSchedule_FullMethodName         = "/schedule.ScheduleService/CreateSchedule"
+	ScheduleService_UpdateScheduleStatus_FullMethodName   = "/schedule.ScheduleService/UpdateScheduleStatus"
+	ScheduleService_DeleteSchedule_FullMethodName         = "/schedule.ScheduleService/DeleteSchedule"
+	ScheduleService_ExtendHold_FullMethodName             = "/schedule.ScheduleService/ExtendHold"
+	ScheduleService_WatchSchedules_FullMethodName         = "/schedule.ScheduleService/WatchSchedules"
+	ScheduleService_GetScheduleTemplates_FullMethodName   = "/schedule.ScheduleService/GetScheduleTemplates"
+	ScheduleService_CreateScheduleTemplate_FullMethodName = "/schedule.ScheduleService/CreateScheduleTemplate"
+	ScheduleService_DeleteScheduleTemplate_FullMethodName = "/schedule.ScheduleService/DeleteScheduleTemplate"
+	ScheduleService_GenerateSchedules_FullMethodName      = "/schedule.ScheduleService/GenerateSchedules"
+	ScheduleService_GetBlackouts_FullMethodName           = "/schedule.ScheduleService/GetBlackouts"
+	ScheduleService_CreateBlackout_FullMethodName         = "/schedule.ScheduleService/CreateBlackout"
+	ScheduleService_DeleteBlackout_FullMethodName         = "/schedule.ScheduleService/DeleteBlackout"
+)
+
+// ScheduleServiceClient is the client API for ScheduleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScheduleServiceClient interface {
+	GetSchedule(ctx context.Context, in *Id, opts ...grpc.CallOption) (*GetScheduleResponse, error)
+	GetSchedules(ctx context.Context, in *GetSchedulesRequest, opts ...grpc.CallOption) (*GetSchedulesResponse, error)
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	UpdateScheduleStatus(ctx context.Context, in *UpdateScheduleStatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	DeleteSchedule(ctx context.Context, in *Id, opts ...grpc.CallOption) (*StatusResponse, error)
+	ExtendHold(ctx context.Context, in *Id, opts ...grpc.CallOption) (*ExtendHoldResponse, error)
+	WatchSchedules(ctx context.Context, in *WatchSchedulesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScheduleEvent], error)
+	GetScheduleTemplates(ctx context.Context, in *GetScheduleTemplatesRequest, opts ...grpc.CallOption) (*GetScheduleTemplatesResponse, error)
+	CreateScheduleTemplate(ctx context.Context, in *CreateScheduleTemplateRequest, opts ...grpc.CallOption) (*CreateScheduleTemplateResponse, error)
+	DeleteScheduleTemplate(ctx context.Context, in *Id, opts ...grpc.CallOption) (*StatusResponse, error)
+	GenerateSchedules(ctx context.Context, in *GenerateSchedulesRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	GetBlackouts(ctx context.Context, in *GetBlackoutsRequest, opts ...grpc.CallOption) (*GetBlackoutsResponse, error)
+	CreateBlackout(ctx context.Context, in *CreateBlackoutRequest, opts ...grpc.CallOption) (*CreateBlackoutResponse, error)
+	DeleteBlackout(ctx context.Context, in *Id, opts ...grpc.CallOption) (*StatusResponse, error)
+}
+
+type scheduleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScheduleServiceClient(cc grpc.ClientConnInterface) ScheduleServiceClient {
+	return &scheduleServiceClient{cc}
+}
+
+func (c *scheduleServiceClient) GetSchedule(ctx context.Context, in *Id, opts ...grpc.CallOption) (*GetScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScheduleResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_GetSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) GetSchedules(ctx context.Context, in *GetSchedulesRequest, opts ...grpc.CallOption) (*GetSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSchedulesResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_GetSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_CreateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) UpdateScheduleStatus(ctx context.Context, in *UpdateScheduleStatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_UpdateScheduleStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) DeleteSchedule(ctx context.Context, in *Id, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_DeleteSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) ExtendHold(ctx context.Context, in *Id, opts ...grpc.CallOption) (*ExtendHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendHoldResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_ExtendHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) WatchSchedules(ctx context.Context, in *WatchSchedulesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScheduleEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScheduleService_ServiceDesc.Streams[0], ScheduleService_WatchSchedules_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSchedulesRequest, ScheduleEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScheduleService_WatchSchedulesClient = grpc.ServerStreamingClient[ScheduleEvent]
+
+func (c *scheduleServiceClient) GetScheduleTemplates(ctx context.Context, in *GetScheduleTemplatesRequest, opts ...grpc.CallOption) (*GetScheduleTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScheduleTemplatesResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_GetScheduleTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) CreateScheduleTemplate(ctx context.Context, in *CreateScheduleTemplateRequest, opts ...grpc.CallOption) (*CreateScheduleTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateScheduleTemplateResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_CreateScheduleTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) DeleteScheduleTemplate(ctx context.Context, in *Id, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_DeleteScheduleTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) GenerateSchedules(ctx context.Context, in *GenerateSchedulesRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_GenerateSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) GetBlackouts(ctx context.Context, in *GetBlackoutsRequest, opts ...grpc.CallOption) (*GetBlackoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlackoutsResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_GetBlackouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) CreateBlackout(ctx context.Context, in *CreateBlackoutRequest, opts ...grpc.CallOption) (*CreateBlackoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBlackoutResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_CreateBlackout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) DeleteBlackout(ctx context.Context, in *Id, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_DeleteBlackout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScheduleServiceServer is the server API for ScheduleService service.
+// All implementations must embed UnimplementedScheduleServiceServer
+// for forward compatibility.
+type ScheduleServiceServer interface {
+	GetSchedule(context.Context, *Id) (*GetScheduleResponse, error)
+	GetSchedules(context.Context, *GetSchedulesRequest) (*GetSchedulesResponse, error)
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	UpdateScheduleStatus(context.Context, *UpdateScheduleStatusRequest) (*StatusResponse, error)
+	DeleteSchedule(context.Context, *Id) (*StatusResponse, error)
+	ExtendHold(context.Context, *Id) (*ExtendHoldResponse, error)
+	WatchSchedules(*WatchSchedulesRequest, grpc.ServerStreamingServer[ScheduleEvent]) error
+	GetScheduleTemplates(context.Context, *GetScheduleTemplatesRequest) (*GetScheduleTemplatesResponse, error)
+	CreateScheduleTemplate(context.Context, *CreateScheduleTemplateRequest) (*CreateScheduleTemplateResponse, error)
+	DeleteScheduleTemplate(context.Context, *Id) (*StatusResponse, error)
+	GenerateSchedules(context.Context, *GenerateSchedulesRequest) (*StatusResponse, error)
+	GetBlackouts(context.Context, *GetBlackoutsRequest) (*GetBlackoutsResponse, error)
+	CreateBlackout(context.Context, *CreateBlackoutRequest) (*CreateBlackoutResponse, error)
+	DeleteBlackout(context.Context, *Id) (*StatusResponse, error)
+	mustEmbedUnimplementedScheduleServiceServer()
+}
+
+// UnimplementedScheduleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedScheduleServiceServer struct{}
+
+func (UnimplementedScheduleServiceServer) GetSchedule(context.Context, *Id) (*GetScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedule not implemented")
+}
+func (UnimplementedScheduleServiceServer) GetSchedules(context.Context, *GetSchedulesRequest) (*GetSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedules not implemented")
+}
+func (UnimplementedScheduleServiceServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedScheduleServiceServer) UpdateScheduleStatus(context.Context, *UpdateScheduleStatusRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduleStatus not implemented")
+}
+func (UnimplementedScheduleServiceServer) DeleteSchedule(context.Context, *Id) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
+func (UnimplementedScheduleServiceServer) ExtendHold(context.Context, *Id) (*ExtendHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendHold not implemented")
+}
+func (UnimplementedScheduleServiceServer) WatchSchedules(*WatchSchedulesRequest, grpc.ServerStreamingServer[ScheduleEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSchedules not implemented")
+}
+func (UnimplementedScheduleServiceServer) GetScheduleTemplates(context.Context, *GetScheduleTemplatesRequest) (*GetScheduleTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduleTemplates not implemented")
+}
+func (UnimplementedScheduleServiceServer) CreateScheduleTemplate(context.Context, *CreateScheduleTemplateRequest) (*CreateScheduleTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduleTemplate not implemented")
+}
+func (UnimplementedScheduleServiceServer) DeleteScheduleTemplate(context.Context, *Id) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduleTemplate not implemented")
+}
+func (UnimplementedScheduleServiceServer) GenerateSchedules(context.Context, *GenerateSchedulesRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateSchedules not implemented")
+}
+func (UnimplementedScheduleServiceServer) GetBlackouts(context.Context, *GetBlackoutsRequest) (*GetBlackoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlackouts not implemented")
+}
+func (UnimplementedScheduleServiceServer) CreateBlackout(context.Context, *CreateBlackoutRequest) (*CreateBlackoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBlackout not implemented")
+}
+func (UnimplementedScheduleServiceServer) DeleteBlackout(context.Context, *Id) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBlackout not implemented")
+}
+func (UnimplementedScheduleServiceServer) mustEmbedUnimplementedScheduleServiceServer() {}
+func (UnimplementedScheduleServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeScheduleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScheduleServiceServer will
+// result in compilation errors.
+type UnsafeScheduleServiceServer interface {
+	mustEmbedUnimplementedScheduleServiceServer()
+}
+
+func RegisterScheduleServiceServer(s grpc.ServiceRegistrar, srv ScheduleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedScheduleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ScheduleService_ServiceDesc, srv)
+}
+
+func _ScheduleService_GetSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GetSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GetSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GetSchedule(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_GetSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GetSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GetSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GetSchedules(ctx, req.(*GetSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_CreateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_UpdateScheduleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).UpdateScheduleStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_UpdateScheduleStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).UpdateScheduleStatus(ctx, req.(*UpdateScheduleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_DeleteSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).DeleteSchedule(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_ExtendHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).ExtendHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_ExtendHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).ExtendHold(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_WatchSchedules_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSchedulesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScheduleServiceServer).WatchSchedules(m, &grpc.GenericServerStream[WatchSchedulesRequest, ScheduleEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScheduleService_WatchSchedulesServer = grpc.ServerStreamingServer[ScheduleEvent]
+
+func _ScheduleService_GetScheduleTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GetScheduleTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GetScheduleTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GetScheduleTemplates(ctx, req.(*GetScheduleTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_CreateScheduleTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).CreateScheduleTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_CreateScheduleTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).CreateScheduleTemplate(ctx, req.(*CreateScheduleTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_DeleteScheduleTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).DeleteScheduleTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_DeleteScheduleTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).DeleteScheduleTemplate(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_GenerateSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GenerateSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GenerateSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GenerateSchedules(ctx, req.(*GenerateSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_GetBlackouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlackoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).GetBlackouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_GetBlackouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).GetBlackouts(ctx, req.(*GetBlackoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_CreateBlackout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBlackoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).CreateBlackout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_CreateBlackout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).CreateBlackout(ctx, req.(*CreateBlackoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_DeleteBlackout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).DeleteBlackout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_DeleteBlackout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).DeleteBlackout(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScheduleService_ServiceDesc is the grpc.ServiceDesc for ScheduleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScheduleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "schedule.ScheduleService",
+	HandlerType: (*ScheduleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSchedule",
+			Handler:    _ScheduleService_GetSchedule_Handler,
+		},
+		{
+			MethodName: "GetSchedules",
+			Handler:    _ScheduleService_GetSchedules_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _ScheduleService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateScheduleStatus",
+			Handler:    _ScheduleService_UpdateScheduleStatus_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _ScheduleService_DeleteSchedule_Handler,
+		},
+		{
+			MethodName: "ExtendHold",
+			Handler:    _ScheduleService_ExtendHold_Handler,
+		},
+		{
+			MethodName: "GetScheduleTemplates",
+			Handler:    _ScheduleService_GetScheduleTemplates_Handler,
+		},
+		{
+			MethodName: "CreateScheduleTemplate",
+			Handler:    _ScheduleService_CreateScheduleTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteScheduleTemplate",
+			Handler:    _ScheduleService_DeleteScheduleTemplate_Handler,
+		},
+		{
+			MethodName: "GenerateSchedules",
+			Handler:    _ScheduleService_GenerateSchedules_Handler,
+		},
+		{
+			MethodName: "GetBlackouts",
+			Handler:    _ScheduleService_GetBlackouts_Handler,
+		},
+		{
+			MethodName: "CreateBlackout",
+			Handler:    _ScheduleService_CreateBlackout_Handler,
+		},
+		{
+			MethodName: "DeleteBlackout",
+			Handler:    _ScheduleService_DeleteBlackout_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSchedules",
+			Handler:       _ScheduleService_WatchSchedules_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "schedule/schedule.proto",
+}
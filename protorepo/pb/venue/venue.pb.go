@@ -0,0 +1,948 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: venue/venue.proto
+
+package venue
+
+import (
+	pagination "github.com/DevisArya/learn-microservices-protorepo/pb/pagination"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Id struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Id) Reset() {
+	*x = Id{}
+	mi := &file_venue_venue_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Id) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Id) ProtoMessage() {}
+
+func (x *Id) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Id.ProtoReflect.Descriptor instead.
+func (*Id) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Id) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Venue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,6,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Email         string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+	OpeningHours  string                 `protobuf:"bytes,8,opt,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
+	FieldIds      []uint32               `protobuf:"varint,9,rep,packed,name=field_ids,json=fieldIds,proto3" json:"field_ids,omitempty"`
+	Timezone      string                 `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Venue) Reset() {
+	*x = Venue{}
+	mi := &file_venue_venue_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Venue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Venue) ProtoMessage() {}
+
+func (x *Venue) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Venue.ProtoReflect.Descriptor instead.
+func (*Venue) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Venue) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Venue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Venue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Venue) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Venue) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Venue) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *Venue) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Venue) GetOpeningHours() string {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return ""
+}
+
+func (x *Venue) GetFieldIds() []uint32 {
+	if x != nil {
+		return x.FieldIds
+	}
+	return nil
+}
+
+func (x *Venue) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type GetVenuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVenuesRequest) Reset() {
+	*x = GetVenuesRequest{}
+	mi := &file_venue_venue_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVenuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVenuesRequest) ProtoMessage() {}
+
+func (x *GetVenuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVenuesRequest.ProtoReflect.Descriptor instead.
+func (*GetVenuesRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetVenuesRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetVenuesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetVenuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *pagination.Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data          []*Venue               `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVenuesResponse) Reset() {
+	*x = GetVenuesResponse{}
+	mi := &file_venue_venue_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVenuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVenuesResponse) ProtoMessage() {}
+
+func (x *GetVenuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVenuesResponse.ProtoReflect.Descriptor instead.
+func (*GetVenuesResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetVenuesResponse) GetPagination() *pagination.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetVenuesResponse) GetData() []*Venue {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetVenueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venue         *Venue                 `protobuf:"bytes,1,opt,name=venue,proto3" json:"venue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVenueResponse) Reset() {
+	*x = GetVenueResponse{}
+	mi := &file_venue_venue_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVenueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVenueResponse) ProtoMessage() {}
+
+func (x *GetVenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVenueResponse.ProtoReflect.Descriptor instead.
+func (*GetVenueResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetVenueResponse) GetVenue() *Venue {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
+}
+
+type CreateVenueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Email         string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	OpeningHours  string                 `protobuf:"bytes,7,opt,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
+	Timezone      string                 `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVenueRequest) Reset() {
+	*x = CreateVenueRequest{}
+	mi := &file_venue_venue_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVenueRequest) ProtoMessage() {}
+
+func (x *CreateVenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVenueRequest.ProtoReflect.Descriptor instead.
+func (*CreateVenueRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateVenueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVenueRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateVenueRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateVenueRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *CreateVenueRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *CreateVenueRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateVenueRequest) GetOpeningHours() string {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return ""
+}
+
+func (x *CreateVenueRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type CreateVenueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVenueResponse) Reset() {
+	*x = CreateVenueResponse{}
+	mi := &file_venue_venue_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVenueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVenueResponse) ProtoMessage() {}
+
+func (x *CreateVenueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVenueResponse.ProtoReflect.Descriptor instead.
+func (*CreateVenueResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateVenueResponse) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateVenueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UpdateVenueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,6,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Email         string                 `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+	OpeningHours  string                 `protobuf:"bytes,8,opt,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
+	Timezone      string                 `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVenueRequest) Reset() {
+	*x = UpdateVenueRequest{}
+	mi := &file_venue_venue_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVenueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVenueRequest) ProtoMessage() {}
+
+func (x *UpdateVenueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVenueRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVenueRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateVenueRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateVenueRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateVenueRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateVenueRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateVenueRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *UpdateVenueRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *UpdateVenueRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateVenueRequest) GetOpeningHours() string {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return ""
+}
+
+func (x *UpdateVenueRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type GetNearestVenuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Limit         uint32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	MaxDistanceKm float64                `protobuf:"fixed64,4,opt,name=max_distance_km,json=maxDistanceKm,proto3" json:"max_distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNearestVenuesRequest) Reset() {
+	*x = GetNearestVenuesRequest{}
+	mi := &file_venue_venue_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNearestVenuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNearestVenuesRequest) ProtoMessage() {}
+
+func (x *GetNearestVenuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNearestVenuesRequest.ProtoReflect.Descriptor instead.
+func (*GetNearestVenuesRequest) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetNearestVenuesRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetNearestVenuesRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetNearestVenuesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetNearestVenuesRequest) GetMaxDistanceKm() float64 {
+	if x != nil {
+		return x.MaxDistanceKm
+	}
+	return 0
+}
+
+type VenueDistance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Venue         *Venue                 `protobuf:"bytes,1,opt,name=venue,proto3" json:"venue,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VenueDistance) Reset() {
+	*x = VenueDistance{}
+	mi := &file_venue_venue_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VenueDistance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VenueDistance) ProtoMessage() {}
+
+func (x *VenueDistance) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VenueDistance.ProtoReflect.Descriptor instead.
+func (*VenueDistance) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VenueDistance) GetVenue() *Venue {
+	if x != nil {
+		return x.Venue
+	}
+	return nil
+}
+
+func (x *VenueDistance) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+type GetNearestVenuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*VenueDistance       `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNearestVenuesResponse) Reset() {
+	*x = GetNearestVenuesResponse{}
+	mi := &file_venue_venue_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNearestVenuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNearestVenuesResponse) ProtoMessage() {}
+
+func (x *GetNearestVenuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNearestVenuesResponse.ProtoReflect.Descriptor instead.
+func (*GetNearestVenuesResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetNearestVenuesResponse) GetData() []*VenueDistance {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	mi := &file_venue_venue_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_venue_venue_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_venue_venue_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_venue_venue_proto protoreflect.FileDescriptor
+
+const file_venue_venue_proto_rawDesc = "" +
+	"\n" +
+	"\x11venue/venue.proto\x12\x05venue\x1a\x1bpagination/pagination.proto\"\x14\n" +
+	"\x02Id\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\x96\x02\n" +
+	"\x05Venue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x12!\n" +
+	"\fphone_number\x18\x06 \x01(\tR\vphoneNumber\x12\x14\n" +
+	"\x05email\x18\a \x01(\tR\x05email\x12#\n" +
+	"\ropening_hours\x18\b \x01(\tR\fopeningHours\x12\x1b\n" +
+	"\tfield_ids\x18\t \x03(\rR\bfieldIds\x12\x1a\n" +
+	"\btimezone\x18\n" +
+	" \x01(\tR\btimezone\"<\n" +
+	"\x10GetVenuesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"m\n" +
+	"\x11GetVenuesResponse\x126\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x16.pagination.PaginationR\n" +
+	"pagination\x12 \n" +
+	"\x04data\x18\x02 \x03(\v2\f.venue.VenueR\x04data\"6\n" +
+	"\x10GetVenueResponse\x12\"\n" +
+	"\x05venue\x18\x01 \x01(\v2\f.venue.VenueR\x05venue\"\xf6\x01\n" +
+	"\x12CreateVenueRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12!\n" +
+	"\fphone_number\x18\x05 \x01(\tR\vphoneNumber\x12\x14\n" +
+	"\x05email\x18\x06 \x01(\tR\x05email\x12#\n" +
+	"\ropening_hours\x18\a \x01(\tR\fopeningHours\x12\x1a\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\"?\n" +
+	"\x13CreateVenueResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x86\x02\n" +
+	"\x12UpdateVenueRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x12!\n" +
+	"\fphone_number\x18\x06 \x01(\tR\vphoneNumber\x12\x14\n" +
+	"\x05email\x18\a \x01(\tR\x05email\x12#\n" +
+	"\ropening_hours\x18\b \x01(\tR\fopeningHours\x12\x1a\n" +
+	"\btimezone\x18\t \x01(\tR\btimezone\"\x91\x01\n" +
+	"\x17GetNearestVenuesRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\x12&\n" +
+	"\x0fmax_distance_km\x18\x04 \x01(\x01R\rmaxDistanceKm\"T\n" +
+	"\rVenueDistance\x12\"\n" +
+	"\x05venue\x18\x01 \x01(\v2\f.venue.VenueR\x05venue\x12\x1f\n" +
+	"\vdistance_km\x18\x02 \x01(\x01R\n" +
+	"distanceKm\"D\n" +
+	"\x18GetNearestVenuesResponse\x12(\n" +
+	"\x04data\x18\x01 \x03(\v2\x14.venue.VenueDistanceR\x04data\"*\n" +
+	"\x0eStatusResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage2\x8b\x03\n" +
+	"\fVenueService\x12>\n" +
+	"\tGetVenues\x12\x17.venue.GetVenuesRequest\x1a\x18.venue.GetVenuesResponse\x12.\n" +
+	"\bGetVenue\x12\t.venue.Id\x1a\x17.venue.GetVenueResponse\x12D\n" +
+	"\vCreateVenue\x12\x19.venue.CreateVenueRequest\x1a\x1a.venue.CreateVenueResponse\x12?\n" +
+	"\vUpdateVenue\x12\x19.venue.UpdateVenueRequest\x1a\x15.venue.StatusResponse\x12/\n" +
+	"\vDeleteVenue\x12\t.venue.Id\x1a\x15.venue.StatusResponse\x12S\n" +
+	"\x10GetNearestVenues\x12\x1e.venue.GetNearestVenuesRequest\x1a\x1f.venue.GetNearestVenuesResponseB=Z;github.com/DevisArya/learn-microservices-protorepo/pb/venueb\x06proto3"
+
+var (
+	file_venue_venue_proto_rawDescOnce sync.Once
+	file_venue_venue_proto_rawDescData []byte
+)
+
+func file_venue_venue_proto_rawDescGZIP() []byte {
+	file_venue_venue_proto_rawDescOnce.Do(func() {
+		file_venue_venue_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_venue_venue_proto_rawDesc), len(file_venue_venue_proto_rawDesc)))
+	})
+	return file_venue_venue_proto_rawDescData
+}
+
+var file_venue_venue_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_venue_venue_proto_goTypes = []any{
+	(*Id)(nil),                       // 0: venue.Id
+	(*Venue)(nil),                    // 1: venue.Venue
+	(*GetVenuesRequest)(nil),         // 2: venue.GetVenuesRequest
+	(*GetVenuesResponse)(nil),        // 3: venue.GetVenuesResponse
+	(*GetVenueResponse)(nil),         // 4: venue.GetVenueResponse
+	(*CreateVenueRequest)(nil),       // 5: venue.CreateVenueRequest
+	(*CreateVenueResponse)(nil),      // 6: venue.CreateVenueResponse
+	(*UpdateVenueRequest)(nil),       // 7: venue.UpdateVenueRequest
+	(*GetNearestVenuesRequest)(nil),  // 8: venue.GetNearestVenuesRequest
+	(*VenueDistance)(nil),            // 9: venue.VenueDistance
+	(*GetNearestVenuesResponse)(nil), // 10: venue.GetNearestVenuesResponse
+	(*StatusResponse)(nil),           // 11: venue.StatusResponse
+	(*pagination.Pagination)(nil),    // 12: pagination.Pagination
+}
+var file_venue_venue_proto_depIdxs = []int32{
+	12, // 0: venue.GetVenuesResponse.pagination:type_name -> pagination.Pagination
+	1,  // 1: venue.GetVenuesResponse.data:type_name -> venue.Venue
+	1,  // 2: venue.GetVenueResponse.venue:type_name -> venue.Venue
+	1,  // 3: venue.VenueDistance.venue:type_name -> venue.Venue
+	9,  // 4: venue.GetNearestVenuesResponse.data:type_name -> venue.VenueDistance
+	2,  // 5: venue.VenueService.GetVenues:input_type -> venue.GetVenuesRequest
+	0,  // 6: venue.VenueService.GetVenue:input_type -> venue.Id
+	5,  // 7: venue.VenueService.CreateVenue:input_type -> venue.CreateVenueRequest
+	7,  // 8: venue.VenueService.UpdateVenue:input_type -> venue.UpdateVenueRequest
+	0,  // 9: venue.VenueService.DeleteVenue:input_type -> venue.Id
+	8,  // 10: venue.VenueService.GetNearestVenues:input_type -> venue.GetNearestVenuesRequest
+	3,  // 11: venue.VenueService.GetVenues:output_type -> venue.GetVenuesResponse
+	4,  // 12: venue.VenueService.GetVenue:output_type -> venue.GetVenueResponse
+	6,  // 13: venue.VenueService.CreateVenue:output_type -> venue.CreateVenueResponse
+	11, // 14: venue.VenueService.UpdateVenue:output_type -> venue.StatusResponse
+	11, // 15: venue.VenueService.DeleteVenue:output_type -> venue.StatusResponse
+	10, // 16: venue.VenueService.GetNearestVenues:output_type -> venue.GetNearestVenuesResponse
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_venue_venue_proto_init() }
+func file_venue_venue_proto_init() {
+	if File_venue_venue_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_venue_venue_proto_rawDesc), len(file_venue_venue_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_venue_venue_proto_goTypes,
+		DependencyIndexes: file_venue_venue_proto_depIdxs,
+		MessageInfos:      file_venue_venue_proto_msgTypes,
+	}.Build()
+	File_venue_venue_proto = out.File
+	file_venue_venue_proto_goTypes = nil
+	file_venue_venue_proto_depIdxs = nil
+}
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: venue/venue.proto
+
+package venue
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	VenueService_GetVenues_FullMethodName        = "/venue.VenueService/GetVenues"
+	VenueService_GetVenue_FullMethodName         = "/venue.VenueService/GetVenue"
+	VenueService_CreateVenue_FullMethodName      = "/venue.VenueService/CreateVenue"
+	VenueService_UpdateVenue_FullMethodName      = "/venue.VenueService/UpdateVenue"
+	VenueService_DeleteVenue_FullMethodName      = "/venue.VenueService/DeleteVenue"
+	VenueService_GetNearestVenues_FullMethodName = "/venue.VenueService/GetNearestVenues"
+)
+
+// VenueServiceClient is the client API for VenueService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VenueServiceClient interface {
+	GetVenues(ctx context.Context, in *GetVenuesRequest, opts ...grpc.CallOption) (*GetVenuesResponse, error)
+	GetVenue(ctx context.Context, in *Id, opts ...grpc.CallOption) (*GetVenueResponse, error)
+	CreateVenue(ctx context.Context, in *CreateVenueRequest, opts ...grpc.CallOption) (*CreateVenueResponse, error)
+	UpdateVenue(ctx context.Context, in *UpdateVenueRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	DeleteVenue(ctx context.Context, in *Id, opts ...grpc.CallOption) (*StatusResponse, error)
+	GetNearestVenues(ctx context.Context, in *GetNearestVenuesRequest, opts ...grpc.CallOption) (*GetNearestVenuesResponse, error)
+}
+
+type venueServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVenueServiceClient(cc grpc.ClientConnInterface) VenueServiceClient {
+	return &venueServiceClient{cc}
+}
+
+func (c *venueServiceClient) GetVenues(ctx context.Context, in *GetVenuesRequest, opts ...grpc.CallOption) (*GetVenuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVenuesResponse)
+	err := c.cc.Invoke(ctx, VenueService_GetVenues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) GetVenue(ctx context.Context, in *Id, opts ...grpc.CallOption) (*GetVenueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVenueResponse)
+	err := c.cc.Invoke(ctx, VenueService_GetVenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) CreateVenue(ctx context.Context, in *CreateVenueRequest, opts ...grpc.CallOption) (*CreateVenueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVenueResponse)
+	err := c.cc.Invoke(ctx, VenueService_CreateVenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) UpdateVenue(ctx context.Context, in *UpdateVenueRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, VenueService_UpdateVenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) DeleteVenue(ctx context.Context, in *Id, opts ...grpc.CallOption) (*StatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, VenueService_DeleteVenue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *venueServiceClient) GetNearestVenues(ctx context.Context, in *GetNearestVenuesRequest, opts ...grpc.CallOption) (*GetNearestVenuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNearestVenuesResponse)
+	err := c.cc.Invoke(ctx, VenueService_GetNearestVenues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VenueServiceServer is the server API for VenueService service.
+// All implementations must embed UnimplementedVenueServiceServer
+// for forward compatibility.
+type VenueServiceServer interface {
+	GetVenues(context.Context, *GetVenuesRequest) (*GetVenuesResponse, error)
+	GetVenue(context.Context, *Id) (*GetVenueResponse, error)
+	CreateVenue(context.Context, *CreateVenueRequest) (*CreateVenueResponse, error)
+	UpdateVenue(context.Context, *UpdateVenueRequest) (*StatusResponse, error)
+	DeleteVenue(context.Context, *Id) (*StatusResponse, error)
+	GetNearestVenues(context.Context, *GetNearestVenuesRequest) (*GetNearestVenuesResponse, error)
+	mustEmbedUnimplementedVenueServiceServer()
+}
+
+// UnimplementedVenueServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVenueServiceServer struct{}
+
+func (UnimplementedVenueServiceServer) GetVenues(context.Context, *GetVenuesRequest) (*GetVenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVenues not implemented")
+}
+func (UnimplementedVenueServiceServer) GetVenue(context.Context, *Id) (*GetVenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVenue not implemented")
+}
+func (UnimplementedVenueServiceServer) CreateVenue(context.Context, *CreateVenueRequest) (*CreateVenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVenue not implemented")
+}
+func (UnimplementedVenueServiceServer) UpdateVenue(context.Context, *UpdateVenueRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVenue not implemented")
+}
+func (UnimplementedVenueServiceServer) DeleteVenue(context.Context, *Id) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVenue not implemented")
+}
+func (UnimplementedVenueServiceServer) GetNearestVenues(context.Context, *GetNearestVenuesRequest) (*GetNearestVenuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNearestVenues not implemented")
+}
+func (UnimplementedVenueServiceServer) mustEmbedUnimplementedVenueServiceServer() {}
+func (UnimplementedVenueServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeVenueServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VenueServiceServer will
+// result in compilation errors.
+type UnsafeVenueServiceServer interface {
+	mustEmbedUnimplementedVenueServiceServer()
+}
+
+func RegisterVenueServiceServer(s grpc.ServiceRegistrar, srv VenueServiceServer) {
+	// If the following call pancis, it indicates UnimplementedVenueServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VenueService_ServiceDesc, srv)
+}
+
+func _VenueService_GetVenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).GetVenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_GetVenues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).GetVenues(ctx, req.(*GetVenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_GetVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).GetVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_GetVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).GetVenue(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_CreateVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).CreateVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_CreateVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).CreateVenue(ctx, req.(*CreateVenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_UpdateVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).UpdateVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_UpdateVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).UpdateVenue(ctx, req.(*UpdateVenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_DeleteVenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Id)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).DeleteVenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_DeleteVenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).DeleteVenue(ctx, req.(*Id))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VenueService_GetNearestVenues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNearestVenuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VenueServiceServer).GetNearestVenues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VenueService_GetNearestVenues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VenueServiceServer).GetNearestVenues(ctx, req.(*GetNearestVenuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VenueService_ServiceDesc is the grpc.ServiceDesc for VenueService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VenueService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "venue.VenueService",
+	HandlerType: (*VenueServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVenues",
+			Handler:    _VenueService_GetVenues_Handler,
+		},
+		{
+			MethodName: "GetVenue",
+			Handler:    _VenueService_GetVenue_Handler,
+		},
+		{
+			MethodName: "CreateVenue",
+			Handler:    _VenueService_CreateVenue_Handler,
+		},
+		{
+			MethodName: "UpdateVenue",
+			Handler:    _VenueService_UpdateVenue_Handler,
+		},
+		{
+			MethodName: "DeleteVenue",
+			Handler:    _VenueService_DeleteVenue_Handler,
+		},
+		{
+			MethodName: "GetNearestVenues",
+			Handler:    _VenueService_GetNearestVenues_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "venue/venue.proto",
+}
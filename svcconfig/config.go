@@ -0,0 +1,103 @@
+// Package svcconfig loads the settings every service needs to run: where it
+// listens, which database it uses and how long it waits. Values start from the
+// defaults of the service, are overridden by an optional YAML or TOML file and
+// then by environment variables.
+package svcconfig
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+// Log levels, from quietest to noisiest.
+const (
+	LogLevelSilent = "silent"
+	LogLevelError  = "error"
+	LogLevelWarn   = "warn"
+	LogLevelInfo   = "info"
+)
+
+type Config struct {
+	// ListenAddr is the host:port the gRPC server listens on.
+	ListenAddr string `yaml:"listen_addr" toml:"listen_addr" env:"LISTEN_ADDR"`
+
+	// LogLevel is one of silent, error, warn or info.
+	LogLevel string `yaml:"log_level" toml:"log_level" env:"LOG_LEVEL"`
+
+	// RequestTimeout bounds every unary call, zero leaves calls unbounded.
+	// ShutdownTimeout is how long in-flight calls may finish on shutdown.
+	RequestTimeout  time.Duration `yaml:"request_timeout" toml:"request_timeout" env:"REQUEST_TIMEOUT"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" toml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+
+	DB Database `yaml:"db" toml:"db" env:"DB"`
+}
+
+type Database struct {
+	DSN string `yaml:"dsn" toml:"dsn" env:"DSN"`
+
+	MaxOpenConns    int           `yaml:"max_open_conns" toml:"max_open_conns" env:"MAX_OPEN_CONNS"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" toml:"max_idle_conns" env:"MAX_IDLE_CONNS"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" toml:"conn_max_lifetime" env:"CONN_MAX_LIFETIME"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" toml:"conn_max_idle_time" env:"CONN_MAX_IDLE_TIME"`
+}
+
+// Defaults returns the settings shared by all services. A service still has
+// to pick its own listen address and DSN.
+func Defaults() Config {
+	return Config{
+		LogLevel:        LogLevelInfo,
+		RequestTimeout:  30 * time.Second,
+		ShutdownTimeout: 10 * time.Second,
+		DB: Database{
+			MaxOpenConns:    20,
+			MaxIdleConns:    5,
+			ConnMaxLifetime: 60 * time.Minute,
+			ConnMaxIdleTime: 60 * time.Minute,
+		},
+	}
+}
+
+// Validate reports every setting that the service can not run with.
+func (c *Config) Validate() error {
+	var errs []error
+
+	if _, _, err := net.SplitHostPort(c.ListenAddr); err != nil {
+		errs = append(errs, fmt.Errorf("listen_addr: %w", err))
+	}
+
+	switch c.LogLevel {
+	case LogLevelSilent, LogLevelError, LogLevelWarn, LogLevelInfo:
+	default:
+		errs = append(errs, fmt.Errorf("log_level: %q is not one of silent, error, warn or info", c.LogLevel))
+	}
+
+	if c.RequestTimeout < 0 {
+		errs = append(errs, errors.New("request_timeout: must not be negative"))
+	}
+	if c.ShutdownTimeout < 0 {
+		errs = append(errs, errors.New("shutdown_timeout: must not be negative"))
+	}
+
+	if c.DB.DSN == "" {
+		errs = append(errs, errors.New("db.dsn: is required"))
+	}
+	if c.DB.MaxOpenConns < 0 {
+		errs = append(errs, errors.New("db.max_open_conns: must not be negative"))
+	}
+	if c.DB.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("db.max_idle_conns: must not be negative"))
+	}
+	if c.DB.MaxOpenConns > 0 && c.DB.MaxIdleConns > c.DB.MaxOpenConns {
+		errs = append(errs, errors.New("db.max_idle_conns: must not exceed db.max_open_conns"))
+	}
+	if c.DB.ConnMaxLifetime < 0 {
+		errs = append(errs, errors.New("db.conn_max_lifetime: must not be negative"))
+	}
+	if c.DB.ConnMaxIdleTime < 0 {
+		errs = append(errs, errors.New("db.conn_max_idle_time: must not be negative"))
+	}
+
+	return errors.Join(errs...)
+}
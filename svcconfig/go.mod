@@ -0,0 +1,8 @@
+module github.com/DevisArya/learn-microservices/svcconfig
+
+go 1.23.5
+
+require (
+	github.com/BurntSushi/toml v1.5.0
+	gopkg.in/yaml.v3 v3.0.1
+)
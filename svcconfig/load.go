@@ -0,0 +1,140 @@
+package svcconfig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Settings is the pointer to a settings struct Load can fill: Config itself or
+// a struct of a service that embeds Config next to its own sections.
+type Settings[T any] interface {
+	*T
+	Validate() error
+}
+
+// Load returns the configuration of the service whose environment variables
+// start with prefix. The file named by <prefix>_CONFIG_FILE, if set, is read
+// over defaults, then every <prefix>_<name> variable is applied, for example
+// FIELD_LISTEN_ADDR or FIELD_DB_MAX_OPEN_CONNS. The fields of an embedded
+// Config share the prefix of the struct embedding it.
+func Load[T any, P Settings[T]](prefix string, defaults T) (*T, error) {
+	cfg := defaults
+
+	if path := os.Getenv(prefix + "_CONFIG_FILE"); path != "" {
+		if err := loadFile(path, &cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := loadEnv(prefix, reflect.ValueOf(&cfg).Elem()); err != nil {
+		return nil, err
+	}
+
+	if err := P(&cfg).Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	return &cfg, nil
+}
+
+// loadFile decodes a YAML or TOML file, picked by its extension, over the
+// struct cfg points to.
+func loadFile(path string, cfg any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+			return fmt.Errorf("decode %s: %w", path, err)
+		}
+	case ".toml":
+		meta, err := toml.Decode(string(data), cfg)
+		if err != nil {
+			return fmt.Errorf("decode %s: %w", path, err)
+		}
+		if undecoded := meta.Undecoded(); len(undecoded) > 0 {
+			return fmt.Errorf("decode %s: unknown key %s", path, undecoded[0])
+		}
+	default:
+		return fmt.Errorf("config file %s: unsupported extension, use .yaml, .yml or .toml", path)
+	}
+
+	return nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// loadEnv sets every field of v tagged env from the variable named by the
+// prefix and the tags of the enclosing structs. Embedded structs without a tag
+// are read under the prefix of v.
+func loadEnv(prefix string, v reflect.Value) error {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		tag := field.Tag.Get("env")
+		if tag == "" {
+			if field.Anonymous && field.Type.Kind() == reflect.Struct {
+				if err := loadEnv(prefix, v.Field(i)); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		name := prefix + "_" + tag
+		value := v.Field(i)
+
+		if value.Kind() == reflect.Struct {
+			if err := loadEnv(name, value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		raw, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case value.Type() == durationType:
+			d, err := time.ParseDuration(raw)
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			value.SetInt(int64(d))
+		case value.Kind() == reflect.String:
+			value.SetString(raw)
+		case value.Kind() == reflect.Int:
+			n, err := strconv.Atoi(raw)
+			if err != nil {
+				return fmt.Errorf("%s: %q is not an integer", name, raw)
+			}
+			value.SetInt(int64(n))
+		case value.CanUint():
+			n, err := strconv.ParseUint(raw, 10, value.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("%s: %q is not an unsigned integer", name, raw)
+			}
+			value.SetUint(n)
+		default:
+			return fmt.Errorf("%s: unsupported type %s", name, value.Type())
+		}
+	}
+
+	return nil
+}
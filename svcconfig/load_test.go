@@ -0,0 +1,214 @@
+package svcconfig
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testSettings embeds Config next to a section of its own, the way the
+// services do.
+type testSettings struct {
+	Config `yaml:",inline"`
+
+	Job testJob `yaml:"job" toml:"job" env:"JOB"`
+}
+
+type testJob struct {
+	Interval time.Duration `yaml:"interval" toml:"interval" env:"INTERVAL"`
+	Weeks    uint32        `yaml:"weeks" toml:"weeks" env:"WEEKS"`
+}
+
+func (s *testSettings) Validate() error {
+	if s.Job.Weeks > 52 {
+		return errors.Join(s.Config.Validate(), errors.New("job.weeks: must not exceed 52"))
+	}
+	return s.Config.Validate()
+}
+
+func testDefaults() testSettings {
+	defaults := testSettings{
+		Config: Defaults(),
+		Job:    testJob{Interval: time.Hour, Weeks: 4},
+	}
+	defaults.ListenAddr = ":50051"
+	defaults.DB.DSN = "test@tcp(localhost:3306)/test"
+	return defaults
+}
+
+func writeConfigFile(t *testing.T, name string, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+const yamlConfig = `
+listen_addr: ":6000"
+log_level: warn
+request_timeout: 5s
+db:
+  dsn: file@tcp(db:3306)/file
+  max_open_conns: 50
+job:
+  weeks: 8
+`
+
+const tomlConfig = `
+listen_addr = ":6000"
+log_level = "warn"
+request_timeout = "5s"
+
+[db]
+dsn = "file@tcp(db:3306)/file"
+max_open_conns = 50
+
+[job]
+weeks = 8
+`
+
+func TestLoadFile(t *testing.T) {
+	for _, file := range []struct {
+		name    string
+		content string
+	}{
+		{"config.yaml", yamlConfig},
+		{"config.yml", yamlConfig},
+		{"config.toml", tomlConfig},
+	} {
+		t.Run(file.name, func(t *testing.T) {
+			t.Setenv("TEST_CONFIG_FILE", writeConfigFile(t, file.name, file.content))
+
+			cfg, err := Load("TEST", testDefaults())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := testDefaults()
+			want.ListenAddr = ":6000"
+			want.LogLevel = LogLevelWarn
+			want.RequestTimeout = 5 * time.Second
+			want.DB.DSN = "file@tcp(db:3306)/file"
+			want.DB.MaxOpenConns = 50
+			want.Job.Weeks = 8
+
+			if *cfg != want {
+				t.Errorf("got %+v\nwant %+v", *cfg, want)
+			}
+		})
+	}
+}
+
+func TestLoadEnvOverridesFile(t *testing.T) {
+	t.Setenv("TEST_CONFIG_FILE", writeConfigFile(t, "config.yaml", yamlConfig))
+	t.Setenv("TEST_LISTEN_ADDR", ":7000")
+	t.Setenv("TEST_DB_DSN", "env:secret@tcp(db:3306)/env")
+	t.Setenv("TEST_DB_MAX_IDLE_CONNS", "10")
+	t.Setenv("TEST_JOB_INTERVAL", "90s")
+	t.Setenv("TEST_JOB_WEEKS", "12")
+
+	cfg, err := Load("TEST", testDefaults())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := testDefaults()
+	want.ListenAddr = ":7000"                   // env over file
+	want.LogLevel = LogLevelWarn                // file only
+	want.RequestTimeout = 5 * time.Second       // file only
+	want.DB.DSN = "env:secret@tcp(db:3306)/env" // env over file
+	want.DB.MaxOpenConns = 50                   // file only
+	want.DB.MaxIdleConns = 10                   // env only
+	want.Job = testJob{Interval: 90 * time.Second, Weeks: 12}
+
+	if *cfg != want {
+		t.Errorf("got %+v\nwant %+v", *cfg, want)
+	}
+}
+
+func TestLoadDefaults(t *testing.T) {
+	cfg, err := Load("TEST", testDefaults())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *cfg != testDefaults() {
+		t.Errorf("got %+v, want the defaults", *cfg)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string // name and content split by the first newline
+		env  map[string]string
+		want string
+	}{
+		{
+			name: "unknown yaml key",
+			file: "config.yaml\nlisten_adr: \":6000\"\n",
+			want: "listen_adr",
+		},
+		{
+			name: "unknown toml key",
+			file: "config.toml\n[job]\nweek = 8\n",
+			want: "unknown key job.week",
+		},
+		{
+			name: "unsupported extension",
+			file: "config.json\n{}\n",
+			want: "unsupported extension",
+		},
+		{
+			name: "missing file",
+			env:  map[string]string{"TEST_CONFIG_FILE": "/nonexistent/config.yaml"},
+			want: "read config file",
+		},
+		{
+			name: "bad duration",
+			env:  map[string]string{"TEST_REQUEST_TIMEOUT": "soon"},
+			want: "TEST_REQUEST_TIMEOUT",
+		},
+		{
+			name: "bad integer",
+			env:  map[string]string{"TEST_DB_MAX_OPEN_CONNS": "many"},
+			want: `TEST_DB_MAX_OPEN_CONNS: "many" is not an integer`,
+		},
+		{
+			name: "negative unsigned",
+			env:  map[string]string{"TEST_JOB_WEEKS": "-1"},
+			want: `TEST_JOB_WEEKS: "-1" is not an unsigned integer`,
+		},
+		{
+			name: "invalid settings",
+			env: map[string]string{
+				"TEST_LOG_LEVEL": "debug",
+				"TEST_JOB_WEEKS": "60",
+			},
+			want: "invalid config",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.file != "" {
+				name, content, _ := strings.Cut(tt.file, "\n")
+				t.Setenv("TEST_CONFIG_FILE", writeConfigFile(t, name, content))
+			}
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			_, err := Load("TEST", testDefaults())
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+// Package apperror holds the typed errors use cases return, so the delivery
+// layer can tell a missing record from a rejected request without matching
+// on message strings.
+package apperror
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind classifies an Error the way a caller has to react to it.
+type Kind uint8
+
+const (
+	KindInternal Kind = iota
+	KindNotFound
+	KindAlreadyExists
+	KindInvalidArgument
+	KindFailedPrecondition
+	KindPermissionDenied
+	KindAborted
+)
+
+// FieldViolation names a request field and why it was rejected.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error is a domain error of a given kind. Its message is safe to show to
+// the caller; Err is kept for errors.Is and logging only.
+type Error struct {
+	Kind       Kind
+	Message    string
+	Violations []FieldViolation
+	Err        error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func newError(kind Kind, format string, args ...any) *Error {
+	return &Error{
+		Kind:    kind,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func NotFound(format string, args ...any) *Error {
+	return newError(KindNotFound, format, args...)
+}
+
+func AlreadyExists(format string, args ...any) *Error {
+	return newError(KindAlreadyExists, format, args...)
+}
+
+func InvalidArgument(format string, args ...any) *Error {
+	return newError(KindInvalidArgument, format, args...)
+}
+
+func FailedPrecondition(format string, args ...any) *Error {
+	return newError(KindFailedPrecondition, format, args...)
+}
+
+func PermissionDenied(format string, args ...any) *Error {
+	return newError(KindPermissionDenied, format, args...)
+}
+
+func Aborted(format string, args ...any) *Error {
+	return newError(KindAborted, format, args...)
+}
+
+// Violation returns an invalid argument error rejecting a single field.
+func Violation(field, description string) *Error {
+	return &Error{
+		Kind:       KindInvalidArgument,
+		Message:    description,
+		Violations: []FieldViolation{{Field: field, Description: description}},
+	}
+}
+
+// Wrap returns an error of kind with message that still matches err.
+func Wrap(kind Kind, err error, format string, args ...any) *Error {
+	e := newError(kind, format, args...)
+	e.Err = err
+	return e
+}
+
+// KindOf returns the kind of the first Error in the chain of err, or
+// KindInternal if there is none.
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return KindInternal
+}
@@ -0,0 +1,107 @@
+// Package grpcserver holds what the gRPC servers of the services share: how
+// use case errors are reported to callers and how long a call may run.
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"gorm.io/gorm"
+)
+
+// Error maps use case errors to a gRPC status. Domain errors keep their
+// message and carry their field violations as BadRequest details, anything
+// unexpected is logged and reported as internal so database messages never
+// reach the caller.
+func Error(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var validationErrors validator.ValidationErrors
+	var domainErr *apperror.Error
+	switch {
+	case errors.As(err, &validationErrors):
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(validationErrors))
+		for _, fieldError := range validationErrors {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fieldError.Field(),
+				Description: validationDescription(fieldError),
+			})
+		}
+		return statusWithDetails(codes.InvalidArgument, "invalid request", &errdetails.BadRequest{FieldViolations: violations})
+	case errors.As(err, &domainErr):
+		return domainStatus(domainErr, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "record not found")
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	log.Printf("grpc: internal error: %v", err)
+	return status.Error(codes.Internal, "internal error")
+}
+
+// domainStatus builds the status of a domain error, message being the text
+// of the whole chain so context added by fmt.Errorf is kept.
+func domainStatus(domainErr *apperror.Error, message string) error {
+	switch domainErr.Kind {
+	case apperror.KindNotFound:
+		return status.Error(codes.NotFound, message)
+	case apperror.KindAlreadyExists:
+		return status.Error(codes.AlreadyExists, message)
+	case apperror.KindInvalidArgument:
+		if len(domainErr.Violations) == 0 {
+			return status.Error(codes.InvalidArgument, message)
+		}
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(domainErr.Violations))
+		for _, v := range domainErr.Violations {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		return statusWithDetails(codes.InvalidArgument, message, &errdetails.BadRequest{FieldViolations: violations})
+	case apperror.KindFailedPrecondition:
+		return statusWithDetails(codes.FailedPrecondition, message, &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{
+				Type:        "STATE",
+				Description: message,
+			}},
+		})
+	case apperror.KindPermissionDenied:
+		return status.Error(codes.PermissionDenied, message)
+	case apperror.KindAborted:
+		return status.Error(codes.Aborted, message)
+	}
+
+	log.Printf("grpc: internal error: %v", domainErr)
+	return status.Error(codes.Internal, "internal error")
+}
+
+// statusWithDetails returns a status carrying details, or a plain one if the
+// details can not be attached.
+func statusWithDetails(code codes.Code, message string, details protoadapt.MessageV1) error {
+	st := status.New(code, message)
+	if detailed, err := st.WithDetails(details); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+func validationDescription(fieldError validator.FieldError) string {
+	if fieldError.Param() != "" {
+		return fmt.Sprintf("failed the %s=%s rule", fieldError.Tag(), fieldError.Param())
+	}
+	return fmt.Sprintf("failed the %s rule", fieldError.Tag())
+}
@@ -0,0 +1,166 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func TestError(t *testing.T) {
+	type request struct {
+		Name string `validate:"required"`
+		Age  int    `validate:"min=18"`
+	}
+	validationErr := validator.New().Struct(request{Age: 3})
+
+	tests := []struct {
+		name       string
+		err        error
+		code       codes.Code
+		message    string
+		violations []string // fields of the BadRequest details
+		// precondition tells a PreconditionFailure detail is attached
+		precondition bool
+	}{
+		{
+			name:    "not found",
+			err:     apperror.NotFound("field %d not found", 7),
+			code:    codes.NotFound,
+			message: "field 7 not found",
+		},
+		{
+			name:    "already exists",
+			err:     apperror.AlreadyExists("review already exists"),
+			code:    codes.AlreadyExists,
+			message: "review already exists",
+		},
+		{
+			name:    "invalid argument",
+			err:     apperror.InvalidArgument("bad cursor"),
+			code:    codes.InvalidArgument,
+			message: "bad cursor",
+		},
+		{
+			name:       "violation",
+			err:        apperror.Violation("MinPrice", "min price must not be greater than max price"),
+			code:       codes.InvalidArgument,
+			message:    "min price must not be greater than max price",
+			violations: []string{"MinPrice"},
+		},
+		{
+			name:         "failed precondition",
+			err:          apperror.FailedPrecondition("slot is already sold"),
+			code:         codes.FailedPrecondition,
+			message:      "slot is already sold",
+			precondition: true,
+		},
+		{
+			name:    "permission denied",
+			err:     apperror.PermissionDenied("permission denied"),
+			code:    codes.PermissionDenied,
+			message: "permission denied",
+		},
+		{
+			name:    "aborted",
+			err:     apperror.Wrap(apperror.KindAborted, errors.New("Error 1213: Deadlock found"), "retry"),
+			code:    codes.Aborted,
+			message: "retry",
+		},
+		{
+			name:    "wrapped domain error keeps its context",
+			err:     fmt.Errorf("field 3: %w", apperror.NotFound("venue not found")),
+			code:    codes.NotFound,
+			message: "field 3: venue not found",
+		},
+		{
+			name:    "internal kind",
+			err:     apperror.Wrap(apperror.KindInternal, errors.New("disk full"), "could not save"),
+			code:    codes.Internal,
+			message: "internal error",
+		},
+		{
+			name:    "unknown kind",
+			err:     &apperror.Error{Kind: apperror.Kind(99), Message: "odd"},
+			code:    codes.Internal,
+			message: "internal error",
+		},
+		{
+			name:       "validation errors",
+			err:        validationErr,
+			code:       codes.InvalidArgument,
+			message:    "invalid request",
+			violations: []string{"Name", "Age"},
+		},
+		{
+			name:    "record not found",
+			err:     fmt.Errorf("find field: %w", gorm.ErrRecordNotFound),
+			code:    codes.NotFound,
+			message: "record not found",
+		},
+		{
+			name:    "canceled",
+			err:     context.Canceled,
+			code:    codes.Canceled,
+			message: context.Canceled.Error(),
+		},
+		{
+			name:    "deadline exceeded",
+			err:     fmt.Errorf("query: %w", context.DeadlineExceeded),
+			code:    codes.DeadlineExceeded,
+			message: "query: " + context.DeadlineExceeded.Error(),
+		},
+		{
+			name:    "status passes through",
+			err:     status.Error(codes.Unauthenticated, "missing caller"),
+			code:    codes.Unauthenticated,
+			message: "missing caller",
+		},
+		{
+			name:    "unknown error",
+			err:     errors.New("Error 1146: Table 'fields' doesn't exist"),
+			code:    codes.Internal,
+			message: "internal error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, ok := status.FromError(Error(tt.err))
+			if !ok {
+				t.Fatalf("got %v, want a status", st.Err())
+			}
+
+			if st.Code() != tt.code || st.Message() != tt.message {
+				t.Errorf("got %s %q, want %s %q", st.Code(), st.Message(), tt.code, tt.message)
+			}
+
+			var violations []string
+			var precondition bool
+			for _, detail := range st.Details() {
+				switch detail := detail.(type) {
+				case *errdetails.BadRequest:
+					for _, v := range detail.GetFieldViolations() {
+						violations = append(violations, v.GetField())
+					}
+				case *errdetails.PreconditionFailure:
+					precondition = true
+				}
+			}
+
+			if fmt.Sprint(violations) != fmt.Sprint(tt.violations) {
+				t.Errorf("got violations of %v, want %v", violations, tt.violations)
+			}
+			if precondition != tt.precondition {
+				t.Errorf("got precondition details %t, want %t", precondition, tt.precondition)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Usage describes the command line of a service migrate binary.
+const Usage = `usage: migrate <command> [steps]
+
+commands:
+  up [n]     apply the next n pending migrations, all of them by default
+  down [n]   revert the last n applied migrations, one by default
+  status     list migrations and when they were applied`
+
+// ErrUsage is returned for a command line that does not match Usage.
+var ErrUsage = errors.New("invalid command line")
+
+// Command is a parsed migrate command line.
+type Command struct {
+	Name  string
+	Steps int
+}
+
+// ParseCommand parses the arguments of a migrate binary, without the program
+// name.
+func ParseCommand(args []string) (*Command, error) {
+	if len(args) < 1 || len(args) > 2 {
+		return nil, ErrUsage
+	}
+
+	command := &Command{Name: args[0]}
+	switch command.Name {
+	case "up", "down":
+	case "status":
+		if len(args) == 2 {
+			return nil, ErrUsage
+		}
+	default:
+		return nil, ErrUsage
+	}
+
+	if len(args) == 2 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			return nil, ErrUsage
+		}
+		command.Steps = n
+	}
+
+	return command, nil
+}
+
+// Run runs command with migrator and reports what it did to w. Migrations
+// applied or reverted before a failure are still reported.
+func (command *Command) Run(ctx context.Context, migrator *Migrator, w io.Writer) error {
+	switch command.Name {
+	case "up":
+		done, err := migrator.Up(ctx, command.Steps)
+		report(w, "applied", done)
+		return err
+	case "down":
+		done, err := migrator.Down(ctx, command.Steps)
+		report(w, "reverted", done)
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.UTC().Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%04d  %-30s  %s\n", status.Version, status.Name, appliedAt)
+		}
+		return nil
+	}
+
+	return ErrUsage
+}
+
+func report(w io.Writer, verb string, migrations []Migration) {
+	if len(migrations) == 0 {
+		fmt.Fprintf(w, "nothing %s\n", verb)
+		return
+	}
+	for _, m := range migrations {
+		fmt.Fprintf(w, "%s %04d_%s\n", verb, m.Version, m.Name)
+	}
+}
@@ -0,0 +1,270 @@
+// Package migration versions the schema of a service. Migrations are pairs
+// of numbered SQL files, NNNN_name.up.sql and NNNN_name.down.sql, usually
+// embedded in the service binary; the versions applied so far are kept in a
+// table of their own.
+package migration
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+type Migrator struct {
+	DB         *gorm.DB
+	Table      string
+	Migrations []Migration
+}
+
+// NewMigrator returns a migrator running the migrations in the root of fsys
+// against DB, keeping the applied versions in table.
+func NewMigrator(DB *gorm.DB, table string, fsys fs.FS) (*Migrator, error) {
+	migrations, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		DB:         DB,
+		Table:      table,
+		Migrations: migrations,
+	}, nil
+}
+
+// Load reads the migrations in the root of fsys ordered by version. Every
+// version needs both an up and a down file.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[uint64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		base := strings.TrimSuffix(entry.Name(), ".sql")
+		direction := path.Ext(base)
+		if direction != ".up" && direction != ".down" {
+			return nil, fmt.Errorf("migration %s: name must end in .up.sql or .down.sql", entry.Name())
+		}
+		base = strings.TrimSuffix(base, direction)
+
+		number, name, ok := strings.Cut(base, "_")
+		version, err := strconv.ParseUint(number, 10, 64)
+		if !ok || err != nil || version == 0 {
+			return nil, fmt.Errorf("migration %s: name must start with a positive version and an underscore", entry.Name())
+		}
+
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, exists := byVersion[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if migration.Name != name {
+			return nil, fmt.Errorf("migration %d: named both %s and %s", version, migration.Name, name)
+		}
+
+		if direction == ".up" {
+			migration.Up = string(data)
+		} else {
+			migration.Down = string(data)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s: needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Up applies up to steps pending migrations in version order, all of them
+// when steps is zero, and returns the ones applied.
+func (migrator *Migrator) Up(ctx context.Context, steps int) ([]Migration, error) {
+	applied, err := migrator.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for _, migration := range migrator.Migrations {
+		if steps > 0 && len(done) == steps {
+			break
+		}
+		if _, ok := applied[migration.Version]; ok {
+			continue
+		}
+
+		err := migrator.run(ctx, migration.Up, func(tx *gorm.DB) error {
+			return tx.Table(migrator.Table).Create(map[string]any{
+				"version":    migration.Version,
+				"name":       migration.Name,
+				"applied_at": time.Now().UTC(),
+			}).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("migration %04d_%s up: %w", migration.Version, migration.Name, err)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Down reverts up to steps applied migrations, newest first, and returns the
+// ones reverted. A zero steps reverts only the latest one.
+func (migrator *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if steps < 1 {
+		steps = 1
+	}
+
+	applied, err := migrator.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []Migration
+	for i := len(migrator.Migrations) - 1; i >= 0 && len(done) < steps; i-- {
+		migration := migrator.Migrations[i]
+		if _, ok := applied[migration.Version]; !ok {
+			continue
+		}
+
+		err := migrator.run(ctx, migration.Down, func(tx *gorm.DB) error {
+			return tx.Exec("DELETE FROM "+migrator.Table+" WHERE version = ?", migration.Version).Error
+		})
+		if err != nil {
+			return done, fmt.Errorf("migration %04d_%s down: %w", migration.Version, migration.Name, err)
+		}
+		done = append(done, migration)
+	}
+
+	return done, nil
+}
+
+// Status lists every known migration with the time it was applied, nil for
+// pending ones.
+func (migrator *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := migrator.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(migrator.Migrations))
+	for _, migration := range migrator.Migrations {
+		status := Status{Migration: migration}
+		if appliedAt, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// applied creates the version table when missing and returns the applied
+// versions with the time they were applied.
+func (migrator *Migrator) applied(ctx context.Context) (map[uint64]time.Time, error) {
+	db := migrator.DB.WithContext(ctx)
+
+	err := db.Exec("CREATE TABLE IF NOT EXISTS " + migrator.Table + ` (
+		version BIGINT UNSIGNED NOT NULL PRIMARY KEY,
+		name VARCHAR(255) NOT NULL,
+		applied_at DATETIME NOT NULL
+	)`).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Version   uint64
+		AppliedAt time.Time
+	}
+	if err := db.Table(migrator.Table).Select("version", "applied_at").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[uint64]time.Time, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row.AppliedAt
+	}
+
+	return applied, nil
+}
+
+// run executes the statements of script one by one and records the result
+// with record in the same transaction. MySQL commits DDL implicitly, so a
+// failing script may leave its earlier statements applied.
+func (migrator *Migrator) run(ctx context.Context, script string, record func(tx *gorm.DB) error) error {
+	return migrator.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, statement := range Statements(script) {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return record(tx)
+	})
+}
+
+// Statements splits a script on the semicolons ending its lines, dropping
+// comment lines.
+func Statements(script string) []string {
+	var result []string
+	var current strings.Builder
+
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		current.WriteString(line)
+		current.WriteString("\n")
+
+		if strings.HasSuffix(trimmed, ";") {
+			result = append(result, strings.TrimSpace(current.String()))
+			current.Reset()
+		}
+	}
+
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		result = append(result, rest)
+	}
+
+	return result
+}
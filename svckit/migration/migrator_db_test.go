@@ -0,0 +1,202 @@
+//go:build cgo
+
+package migration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testMigrations creates three tables, the second migration holding two
+// statements.
+var testMigrations = fstest.MapFS{
+	"0001_create_a.up.sql":   {Data: []byte("CREATE TABLE a (id INTEGER);\n")},
+	"0001_create_a.down.sql": {Data: []byte("DROP TABLE a;\n")},
+	"0002_create_b.up.sql":   {Data: []byte("-- two statements\nCREATE TABLE b (id INTEGER);\nCREATE TABLE b_log (\n\tid INTEGER\n);\n")},
+	"0002_create_b.down.sql": {Data: []byte("DROP TABLE b_log;\nDROP TABLE b;\n")},
+	"0003_create_c.up.sql":   {Data: []byte("CREATE TABLE c (id INTEGER);\n")},
+	"0003_create_c.down.sql": {Data: []byte("DROP TABLE c;\n")},
+}
+
+func newTestMigrator(t *testing.T, fsys fstest.MapFS) *Migrator {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s", filepath.Join(t.TempDir(), "migration.db"))
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	migrator, err := NewMigrator(db, "test_schema_migrations", fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return migrator
+}
+
+func versions(migrations []Migration) []uint64 {
+	result := make([]uint64, 0, len(migrations))
+	for _, m := range migrations {
+		result = append(result, m.Version)
+	}
+	return result
+}
+
+// assertApplied checks the versions recorded as applied and the tables the
+// migrations left behind.
+func assertApplied(t *testing.T, migrator *Migrator, want ...uint64) {
+	t.Helper()
+
+	statuses, err := migrator.Status(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var applied []uint64
+	for _, status := range statuses {
+		if status.AppliedAt != nil {
+			applied = append(applied, status.Version)
+		}
+	}
+	if fmt.Sprint(applied) != fmt.Sprint(want) {
+		t.Fatalf("applied versions = %v, want %v", applied, want)
+	}
+
+	wanted := make(map[uint64]bool, len(want))
+	for _, version := range want {
+		wanted[version] = true
+	}
+
+	tables := map[uint64][]string{1: {"a"}, 2: {"b", "b_log"}, 3: {"c"}}
+	for version, names := range tables {
+		isApplied := wanted[version]
+		for _, name := range names {
+			if exists := migrator.DB.Migrator().HasTable(name); exists != isApplied {
+				t.Errorf("table %s exists = %t, want %t", name, exists, isApplied)
+			}
+		}
+	}
+}
+
+func TestMigratorUpDownStatus(t *testing.T) {
+	migrator := newTestMigrator(t, testMigrations)
+	ctx := context.Background()
+
+	assertApplied(t, migrator)
+
+	done, err := migrator.Up(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versions(done); fmt.Sprint(got) != "[1 2]" {
+		t.Fatalf("up 2 applied %v, want [1 2]", got)
+	}
+	assertApplied(t, migrator, 1, 2)
+
+	done, err = migrator.Up(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versions(done); fmt.Sprint(got) != "[3]" {
+		t.Fatalf("up applied %v, want [3]", got)
+	}
+	assertApplied(t, migrator, 1, 2, 3)
+
+	done, err = migrator.Up(ctx, 0)
+	if err != nil || len(done) != 0 {
+		t.Fatalf("up with nothing pending = %v, %v", versions(done), err)
+	}
+
+	done, err = migrator.Down(ctx, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versions(done); fmt.Sprint(got) != "[3]" {
+		t.Fatalf("down reverted %v, want [3]", got)
+	}
+	assertApplied(t, migrator, 1, 2)
+
+	done, err = migrator.Down(ctx, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := versions(done); fmt.Sprint(got) != "[2 1]" {
+		t.Fatalf("down 5 reverted %v, want [2 1]", got)
+	}
+	assertApplied(t, migrator)
+}
+
+func TestMigratorUpStopsAtFailure(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0001_create_a.up.sql":   testMigrations["0001_create_a.up.sql"],
+		"0001_create_a.down.sql": testMigrations["0001_create_a.down.sql"],
+		"0002_create_b.up.sql":   {Data: []byte("CREATE TABLE b (id INTEGER);\nNOT SQL;\n")},
+		"0002_create_b.down.sql": testMigrations["0002_create_b.down.sql"],
+		"0003_create_c.up.sql":   testMigrations["0003_create_c.up.sql"],
+		"0003_create_c.down.sql": testMigrations["0003_create_c.down.sql"],
+	}
+	migrator := newTestMigrator(t, fsys)
+
+	done, err := migrator.Up(context.Background(), 0)
+	if err == nil {
+		t.Fatal("up succeeded with a broken migration")
+	}
+	if !strings.Contains(err.Error(), "0002_create_b up") {
+		t.Errorf("error %q does not name the failed migration", err)
+	}
+	if got := versions(done); fmt.Sprint(got) != "[1]" {
+		t.Fatalf("up applied %v, want [1]", got)
+	}
+
+	//sqlite rolls back DDL, so the failed migration leaves nothing behind
+	assertApplied(t, migrator, 1)
+}
+
+func TestCommandRunReports(t *testing.T) {
+	migrator := newTestMigrator(t, testMigrations)
+	ctx := context.Background()
+
+	run := func(args ...string) string {
+		t.Helper()
+
+		command, err := ParseCommand(args)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out bytes.Buffer
+		if err := command.Run(ctx, migrator, &out); err != nil {
+			t.Fatal(err)
+		}
+		return out.String()
+	}
+
+	if got, want := run("up", "1"), "applied 0001_create_a\n"; got != want {
+		t.Errorf("up 1 printed %q, want %q", got, want)
+	}
+
+	status := strings.Split(strings.TrimSpace(run("status")), "\n")
+	if len(status) != 3 {
+		t.Fatalf("status printed %d lines, want 3: %q", len(status), status)
+	}
+	if strings.HasSuffix(status[0], "pending") || !strings.HasSuffix(status[1], "pending") || !strings.HasSuffix(status[2], "pending") {
+		t.Errorf("status = %q, want only 0001 applied", status)
+	}
+
+	if got, want := run("down"), "reverted 0001_create_a\n"; got != want {
+		t.Errorf("down printed %q, want %q", got, want)
+	}
+	if got, want := run("down"), "nothing reverted\n"; got != want {
+		t.Errorf("down with nothing applied printed %q, want %q", got, want)
+	}
+}
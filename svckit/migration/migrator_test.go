@@ -0,0 +1,157 @@
+package migration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestStatements(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{
+			name:   "empty",
+			script: "",
+		},
+		{
+			name:   "single statement",
+			script: "CREATE TABLE a (id INT);\n",
+			want:   []string{"CREATE TABLE a (id INT);"},
+		},
+		{
+			name:   "statement over several lines",
+			script: "CREATE TABLE a (\n\tid INT,\n\tname TEXT\n);\n",
+			want:   []string{"CREATE TABLE a (\n\tid INT,\n\tname TEXT\n);"},
+		},
+		{
+			name:   "comments and blank lines are dropped",
+			script: "-- first\n\nDROP TABLE a;\n  -- indented\nDROP TABLE b;\n",
+			want:   []string{"DROP TABLE a;", "DROP TABLE b;"},
+		},
+		{
+			name:   "semicolon inside a line does not split",
+			script: "INSERT INTO a (name) VALUES ('x;y');\n",
+			want:   []string{"INSERT INTO a (name) VALUES ('x;y');"},
+		},
+		{
+			name:   "trailing statement without semicolon",
+			script: "DROP TABLE a;\nDROP TABLE b",
+			want:   []string{"DROP TABLE a;", "DROP TABLE b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Statements(tt.script); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Statements() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadOrdersByVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0010_add_c.up.sql":      {Data: []byte("up 10")},
+		"0010_add_c.down.sql":    {Data: []byte("down 10")},
+		"0002_add_b.up.sql":      {Data: []byte("up 2")},
+		"0002_add_b.down.sql":    {Data: []byte("down 2")},
+		"0001_create_a.up.sql":   {Data: []byte("up 1")},
+		"0001_create_a.down.sql": {Data: []byte("down 1")},
+		"README.md":              {Data: []byte("not a migration")},
+	}
+
+	migrations, err := Load(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Migration{
+		{Version: 1, Name: "create_a", Up: "up 1", Down: "down 1"},
+		{Version: 2, Name: "add_b", Up: "up 2", Down: "down 2"},
+		{Version: 10, Name: "add_c", Up: "up 10", Down: "down 10"},
+	}
+	if !reflect.DeepEqual(migrations, want) {
+		t.Errorf("Load() = %+v, want %+v", migrations, want)
+	}
+}
+
+func TestLoadRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+	}{
+		{
+			name: "missing down file",
+			fsys: fstest.MapFS{"0001_create_a.up.sql": {Data: []byte("up")}},
+		},
+		{
+			name: "missing direction",
+			fsys: fstest.MapFS{"0001_create_a.sql": {Data: []byte("up")}},
+		},
+		{
+			name: "missing version",
+			fsys: fstest.MapFS{
+				"create_a.up.sql":   {Data: []byte("up")},
+				"create_a.down.sql": {Data: []byte("down")},
+			},
+		},
+		{
+			name: "zero version",
+			fsys: fstest.MapFS{
+				"0000_create_a.up.sql":   {Data: []byte("up")},
+				"0000_create_a.down.sql": {Data: []byte("down")},
+			},
+		},
+		{
+			name: "one version with two names",
+			fsys: fstest.MapFS{
+				"0001_create_a.up.sql":   {Data: []byte("up")},
+				"0001_create_b.down.sql": {Data: []byte("down")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if migrations, err := Load(tt.fsys); err == nil {
+				t.Errorf("Load() = %+v, want an error", migrations)
+			}
+		})
+	}
+}
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		args []string
+		want *Command
+	}{
+		{args: []string{"up"}, want: &Command{Name: "up"}},
+		{args: []string{"up", "2"}, want: &Command{Name: "up", Steps: 2}},
+		{args: []string{"down"}, want: &Command{Name: "down"}},
+		{args: []string{"down", "3"}, want: &Command{Name: "down", Steps: 3}},
+		{args: []string{"status"}, want: &Command{Name: "status"}},
+		{args: nil},
+		{args: []string{"status", "1"}},
+		{args: []string{"up", "0"}},
+		{args: []string{"up", "x"}},
+		{args: []string{"up", "1", "2"}},
+		{args: []string{"redo"}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseCommand(tt.args)
+		if tt.want == nil {
+			if !errors.Is(err, ErrUsage) {
+				t.Errorf("ParseCommand(%q) = %+v, %v, want %v", tt.args, got, err, ErrUsage)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseCommand(%q) = %+v, %v, want %+v", tt.args, got, err, tt.want)
+		}
+	}
+}
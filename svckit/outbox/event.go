@@ -0,0 +1,45 @@
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Event is a domain event written in the same database transaction as the
+// change it describes. The relay delivers it afterwards and sets PublishedAt
+// once the publisher accepted it. Services share the table, Source tells
+// whose relay delivers an event.
+type Event struct {
+	Id            uint64     `gorm:"primaryKey"`
+	Source        string     `gorm:"size:50;not null;index:idx_outbox_pending"`
+	Type          string     `gorm:"size:100;not null"`
+	AggregateType string     `gorm:"size:50;not null"`
+	AggregateId   string     `gorm:"size:50;not null"`
+	Payload       string     `gorm:"type:text;not null"`
+	CreatedAt     time.Time  `gorm:"autoCreateTime"`
+	PublishedAt   *time.Time `gorm:"null;index:idx_outbox_pending"`
+	Attempts      uint32     `gorm:"not null;default:0"`
+	LastError     string     `gorm:"size:255"`
+}
+
+func (Event) TableName() string {
+	return "outbox_events"
+}
+
+// NewEvent returns an event of eventType written by source about the
+// aggregate, with payload encoded as JSON.
+func NewEvent(source string, eventType string, aggregateType string, aggregateId uint, payload any) (*Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("encode %s payload: %w", eventType, err)
+	}
+
+	return &Event{
+		Source:        source,
+		Type:          eventType,
+		AggregateType: aggregateType,
+		AggregateId:   fmt.Sprint(aggregateId),
+		Payload:       string(data),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RelayJob runs a relay in the background. Every replica may run one;
+// pending rows are locked while a relay publishes them.
+type RelayJob struct {
+	Relay     Relay
+	BatchSize int
+	Interval  time.Duration
+}
+
+func NewRelayJob(relay Relay, batchSize int, interval time.Duration) *RelayJob {
+	return &RelayJob{
+		Relay:     relay,
+		BatchSize: batchSize,
+		Interval:  interval,
+	}
+}
+
+// Start runs the relay once and then on every interval until ctx is done.
+func (job *RelayJob) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(job.Interval)
+		defer ticker.Stop()
+
+		for {
+			job.run(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (job *RelayJob) run(ctx context.Context) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("outbox relay: %v", err)
+		}
+	}()
+
+	published, err := job.Relay.Relay(ctx, job.BatchSize)
+	if err != nil {
+		log.Printf("outbox relay: %v", err)
+		return
+	}
+
+	if published > 0 {
+		log.Printf("outbox relay: published %d events", published)
+	}
+}
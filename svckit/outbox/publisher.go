@@ -0,0 +1,115 @@
+// Package outbox delivers the domain events a service writes to its outbox
+// table in the same database transaction as the change they describe. The
+// relay publishes pending events in order and marks them published, so an
+// event is never lost once its transaction committed.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Publisher delivers outbox events to their consumers. The relay retries an
+// event until Publish returns nil, so delivery is at-least-once and consumers
+// must tolerate duplicates, e.g. by the event id.
+type Publisher interface {
+	Publish(ctx context.Context, event *Event) error
+}
+
+// MemoryPublisher keeps published events in memory and hands them to its
+// handlers, for tests and for consumers living in the same process.
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	events   []Event
+	handlers []func(ctx context.Context, event *Event) error
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+// Handle registers fn to be called with every published event. An error from
+// fn fails the publish, so the event is delivered again later.
+func (publisher *MemoryPublisher) Handle(fn func(ctx context.Context, event *Event) error) {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	publisher.handlers = append(publisher.handlers, fn)
+}
+
+// Publish implements Publisher
+func (publisher *MemoryPublisher) Publish(ctx context.Context, event *Event) error {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	for _, fn := range publisher.handlers {
+		if err := fn(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	publisher.events = append(publisher.events, *event)
+	return nil
+}
+
+// Events returns the events published so far.
+func (publisher *MemoryPublisher) Events() []Event {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	return append([]Event(nil), publisher.events...)
+}
+
+// LogPublisher writes every event as one JSON line, to stdout or a file when
+// running locally without a broker.
+type LogPublisher struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+func NewLogPublisher(w io.Writer) *LogPublisher {
+	return &LogPublisher{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+// NewFilePublisher returns a LogPublisher appending to the file at path,
+// created if missing.
+func NewFilePublisher(path string) (*LogPublisher, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewLogPublisher(file), nil
+}
+
+type logLine struct {
+	Id            uint64          `json:"id"`
+	Source        string          `json:"source"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateId   string          `json:"aggregate_id"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// Publish implements Publisher
+func (publisher *LogPublisher) Publish(ctx context.Context, event *Event) error {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	return publisher.encoder.Encode(&logLine{
+		Id:            event.Id,
+		Source:        event.Source,
+		Type:          event.Type,
+		AggregateType: event.AggregateType,
+		AggregateId:   event.AggregateId,
+		Payload:       json.RawMessage(event.Payload),
+		CreatedAt:     event.CreatedAt,
+	})
+}
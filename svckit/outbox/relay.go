@@ -0,0 +1,63 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+)
+
+// Relay publishes the pending events written by one source.
+type Relay interface {
+	Relay(ctx context.Context, limit int) (int, error)
+}
+
+type RelayImpl struct {
+	Repository Repository
+	Publisher  Publisher
+	TxManager  txmanager.Manager
+	Source     string
+}
+
+func NewRelay(repository Repository, publisher Publisher, txManager txmanager.Manager, source string) Relay {
+	return &RelayImpl{
+		Repository: repository,
+		Publisher:  publisher,
+		TxManager:  txManager,
+		Source:     source,
+	}
+}
+
+// Relay implements Relay. Up to limit pending events of the source are
+// published in the order they were written and marked as published. The first
+// event the publisher rejects has its attempt recorded and ends the batch, so
+// later events never overtake it; it is retried on the next run. An event may
+// be published again when marking it fails, delivery is at-least-once.
+func (relay *RelayImpl) Relay(ctx context.Context, limit int) (int, error) {
+
+	published := 0
+	err := relay.TxManager.Do(ctx, func(ctx context.Context) error {
+		events, err := relay.Repository.FindPending(ctx, relay.Source, limit)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range *events {
+			if err := relay.Publisher.Publish(ctx, &event); err != nil {
+				return relay.Repository.MarkFailed(ctx, event.Id, err.Error())
+			}
+
+			if err := relay.Repository.MarkPublished(ctx, event.Id, time.Now()); err != nil {
+				return err
+			}
+			published++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return published, nil
+}
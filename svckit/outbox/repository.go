@@ -0,0 +1,86 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository interface {
+	Save(ctx context.Context, event *Event) (*Event, error)
+	FindPending(ctx context.Context, source string, limit int) (*[]Event, error)
+	MarkPublished(ctx context.Context, eventId uint64, publishedAt time.Time) error
+	MarkFailed(ctx context.Context, eventId uint64, lastError string) error
+}
+
+type RepositoryImpl struct {
+	DB *gorm.DB
+}
+
+func NewRepository(DB *gorm.DB) Repository {
+	return &RepositoryImpl{
+		DB: DB,
+	}
+}
+
+// Save implements Repository
+func (repository *RepositoryImpl) Save(ctx context.Context, event *Event) (*Event, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Create(event).Error; err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// FindPending implements Repository. The oldest unpublished events of
+// source are locked, skipping those another relay holds, so several relays
+// can run without delivering the same batch twice.
+func (repository *RepositoryImpl) FindPending(ctx context.Context, source string, limit int) (*[]Event, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var events []Event
+
+	if err := tx.
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("source = ? AND published_at IS NULL", source).
+		Order("id ASC").
+		Limit(limit).
+		Find(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return &events, nil
+}
+
+// MarkPublished implements Repository
+func (repository *RepositoryImpl) MarkPublished(ctx context.Context, eventId uint64, publishedAt time.Time) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if err := tx.Model(&Event{}).Where("id = ?", eventId).Update("published_at", publishedAt).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarkFailed implements Repository
+func (repository *RepositoryImpl) MarkFailed(ctx context.Context, eventId uint64, lastError string) error {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	if len(lastError) > 255 {
+		lastError = lastError[:255]
+	}
+
+	if err := tx.Model(&Event{}).Where("id = ?", eventId).Updates(map[string]interface{}{
+		"attempts":   gorm.Expr("attempts + 1"),
+		"last_error": lastError,
+	}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Package txmanager runs units of work in database transactions carried by
+// the context, so repositories join the transaction of their caller without
+// it being passed along.
+package txmanager
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+// ErrReadOnly is returned when a read-write unit of work is started inside a
+// read-only one.
+var ErrReadOnly = errors.New("read-write transaction inside a read-only transaction")
+
+// Manager runs fn in a transaction. The transaction is committed when fn
+// returns nil and rolled back when it returns an error or panics.
+//
+// Called again inside fn, Do runs in a savepoint of the outer transaction so
+// only the inner work is undone when it fails, and ReadOnly joins the outer
+// transaction as it is.
+type Manager interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+	ReadOnly(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type ManagerImpl struct {
+	DB *gorm.DB
+}
+
+func NewManager(DB *gorm.DB) Manager {
+	return &ManagerImpl{
+		DB: DB,
+	}
+}
+
+type txKey struct{}
+
+type txState struct {
+	tx       *gorm.DB
+	readOnly bool
+}
+
+// Do implements Manager
+func (manager *ManagerImpl) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+
+	if current, ok := ctx.Value(txKey{}).(*txState); ok {
+		if current.readOnly {
+			return ErrReadOnly
+		}
+
+		return current.tx.Transaction(func(tx *gorm.DB) error {
+			return fn(context.WithValue(ctx, txKey{}, &txState{tx: tx}))
+		})
+	}
+
+	return retryable(manager.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, &txState{tx: tx}))
+	}))
+}
+
+// ReadOnly implements Manager
+func (manager *ManagerImpl) ReadOnly(ctx context.Context, fn func(ctx context.Context) error) error {
+
+	if _, ok := ctx.Value(txKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+
+	return retryable(manager.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, &txState{tx: tx, readOnly: true}))
+	}, &sql.TxOptions{ReadOnly: true}))
+}
+
+// MySQL errors of a transaction that lost a lock conflict: it was rolled back
+// as a deadlock victim, or a statement gave up waiting for a lock.
+const (
+	mysqlLockWaitTimeout = 1205
+	mysqlDeadlock        = 1213
+)
+
+// retryable reports a lost lock conflict as aborted, so the caller knows
+// running the unit of work again may succeed; other errors are kept as they
+// are.
+func retryable(err error) error {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) && (mysqlErr.Number == mysqlDeadlock || mysqlErr.Number == mysqlLockWaitTimeout) {
+		return apperror.Wrap(apperror.KindAborted, err, "transaction conflicted with a concurrent one, retry")
+	}
+
+	return err
+}
+
+// DB returns the transaction carried by ctx, or db outside of one, bound to
+// ctx. Repositories run every statement on it.
+func DB(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if current, ok := ctx.Value(txKey{}).(*txState); ok {
+		return current.tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -0,0 +1,41 @@
+package txmanager
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	"github.com/go-sql-driver/mysql"
+)
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		kind apperror.Kind
+	}{
+		{"deadlock", &mysql.MySQLError{Number: mysqlDeadlock, Message: "Deadlock found when trying to get lock"}, apperror.KindAborted},
+		{"lock wait timeout", &mysql.MySQLError{Number: mysqlLockWaitTimeout, Message: "Lock wait timeout exceeded"}, apperror.KindAborted},
+		{"wrapped deadlock", fmt.Errorf("reserve: %w", &mysql.MySQLError{Number: mysqlDeadlock}), apperror.KindAborted},
+		{"duplicate key", &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}, apperror.KindInternal},
+		{"domain error", apperror.FailedPrecondition("sold"), apperror.KindFailedPrecondition},
+		{"other error", errors.New("connection refused"), apperror.KindInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := retryable(tt.err)
+			if got := apperror.KindOf(err); got != tt.kind {
+				t.Errorf("kind = %d, want %d", got, tt.kind)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Errorf("%v no longer matches %v", err, tt.err)
+			}
+		})
+	}
+
+	if err := retryable(nil); err != nil {
+		t.Errorf("retryable(nil) = %v", err)
+	}
+}
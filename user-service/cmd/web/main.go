@@ -3,29 +3,67 @@ package main
 import (
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	outboxkit "github.com/DevisArya/learn-microservices/svckit/outbox"
 	"github.com/DevisArya/learn-microservices/user-service/internal/config"
 
 	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
 )
 
 func main() {
 
+	cfg, err := config.LoadSettings()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
 	validate := validator.New()
-	db := config.NewDB()
+	db := config.NewDB(&cfg.Config)
 
 	bootstrapResult, err := config.Bootstrap(&config.BootstrapConfig{
-		DB:       db,
-		Validate: validate,
+		DB:                  db,
+		Validate:            validate,
+		ListenAddr:          cfg.ListenAddr,
+		RequestTimeout:      cfg.RequestTimeout,
+		OutboxPublisher:     outboxkit.NewLogPublisher(os.Stdout),
+		OutboxRelayInterval: cfg.Outbox.RelayInterval,
+		OutboxBatchSize:     cfg.Outbox.BatchSize,
 	})
 
 	if err != nil {
 		log.Fatalf("failed to bootstrap: %v", err)
 	}
 
-	fmt.Println("gRPC server running on port 50051")
+	go shutdownOnSignal(bootstrapResult.GRPCServer, cfg.ShutdownTimeout)
+
+	fmt.Printf("gRPC server running on %s\n", cfg.ListenAddr)
 
 	if err := bootstrapResult.GRPCServer.Serve(bootstrapResult.Listener); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// shutdownOnSignal stops the server on SIGINT or SIGTERM, letting in-flight
+// calls finish for at most timeout.
+func shutdownOnSignal(server *grpc.Server, timeout time.Duration) {
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+
+	done := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(timeout):
+		server.Stop()
+	}
+}
@@ -4,6 +4,8 @@ go 1.23.5
 
 require (
 	github.com/DevisArya/learn-microservices-protorepo v1.0.5
+	github.com/DevisArya/learn-microservices/svcconfig v0.0.0
+	github.com/DevisArya/learn-microservices/svckit v0.0.0
 	github.com/go-playground/validator/v10 v10.26.0
 	golang.org/x/crypto v0.37.0
 	google.golang.org/grpc v1.72.0
@@ -12,6 +14,7 @@ require (
 )
 
 require (
+	github.com/BurntSushi/toml v1.5.0 // indirect
 	github.com/gabriel-vasile/mimetype v1.4.8 // indirect
 	github.com/go-playground/locales v0.14.1 // indirect
 	github.com/go-playground/universal-translator v0.18.1 // indirect
@@ -24,4 +27,11 @@ require (
 	golang.org/x/text v0.24.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
 	google.golang.org/protobuf v1.36.6 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+replace github.com/DevisArya/learn-microservices-protorepo => ../protorepo
+
+replace github.com/DevisArya/learn-microservices/svcconfig => ../svcconfig
+
+replace github.com/DevisArya/learn-microservices/svckit => ../svckit
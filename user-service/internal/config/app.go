@@ -1,10 +1,16 @@
 package config
 
 import (
+	"context"
 	"net"
+	"time"
 
 	userpb "github.com/DevisArya/learn-microservices-protorepo/pb/user"
+	"github.com/DevisArya/learn-microservices/svckit/grpcserver"
+	outboxkit "github.com/DevisArya/learn-microservices/svckit/outbox"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
 	"github.com/DevisArya/learn-microservices/user-service/internal/delivery/grpcdelivery"
+	"github.com/DevisArya/learn-microservices/user-service/internal/outbox"
 	"github.com/DevisArya/learn-microservices/user-service/internal/repository"
 	"github.com/DevisArya/learn-microservices/user-service/internal/usecase"
 	"github.com/go-playground/validator/v10"
@@ -15,6 +21,18 @@ import (
 type BootstrapConfig struct {
 	DB       *gorm.DB
 	Validate *validator.Validate
+
+	// ListenAddr is where the gRPC server listens, RequestTimeout bounds every
+	// unary call unless zero.
+	ListenAddr     string
+	RequestTimeout time.Duration
+
+	// OutboxPublisher receives the domain events of the outbox, an in-memory
+	// publisher when nil. OutboxRelayInterval is how often the relay runs,
+	// OutboxBatchSize how many events it publishes per run.
+	OutboxPublisher     outboxkit.Publisher
+	OutboxRelayInterval time.Duration
+	OutboxBatchSize     int
 }
 
 type BootstrapResult struct {
@@ -25,19 +43,33 @@ type BootstrapResult struct {
 func Bootstrap(cfg *BootstrapConfig) (*BootstrapResult, error) {
 
 	// Setup TCP listener
-	lis, err := net.Listen("tcp", ":50051")
+	lis, err := net.Listen("tcp", cfg.ListenAddr)
 	if err != nil {
 		return nil, err
 	}
 
 	//Init depedencies
-	fieldRepo := repository.NewUserRepository()
-	fieldUc := usecase.NewUserUseCase(fieldRepo, cfg.DB, cfg.Validate)
+	txManager := txmanager.NewManager(cfg.DB)
+
+	outboxRepo := outboxkit.NewRepository(cfg.DB)
+	outboxPublisher := cfg.OutboxPublisher
+	if outboxPublisher == nil {
+		outboxPublisher = outboxkit.NewMemoryPublisher()
+	}
+	outboxRelay := outboxkit.NewRelay(outboxRepo, outboxPublisher, txManager, outbox.Source)
+
+	fieldRepo := repository.NewUserRepository(cfg.DB)
+	fieldUc := usecase.NewUserUseCase(fieldRepo, outboxRepo, txManager, cfg.Validate)
 	fieldCtrl := grpcdelivery.NewUserController(fieldUc)
 
+	//start background jobs
+	if cfg.OutboxRelayInterval > 0 && cfg.OutboxBatchSize > 0 {
+		outboxkit.NewRelayJob(outboxRelay, cfg.OutboxBatchSize, cfg.OutboxRelayInterval).Start(context.Background())
+	}
+
 	//init grpc server & register service
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(grpcserver.TimeoutInterceptor(cfg.RequestTimeout)))
 	userpb.RegisterUserServiceServer(grpcServer, fieldCtrl)
 
 	return &BootstrapResult{
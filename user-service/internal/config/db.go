@@ -3,16 +3,21 @@ package config
 import (
 	"time"
 
+	"github.com/DevisArya/learn-microservices/svcconfig"
 	"github.com/DevisArya/learn-microservices/user-service/internal/helper"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
 
-func NewDB() *gorm.DB {
-	dsn := "root:12345@tcp(localhost:3306)/field_reservation3?charset=utf8mb4&parseTime=True&loc=Local"
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+// NewDB opens the database with every time read and written in UTC, whatever
+// the time zone of the host.
+func NewDB(cfg *svcconfig.Config) *gorm.DB {
+	db, err := gorm.Open(mysql.Open(cfg.DB.DSN), &gorm.Config{
+		Logger: logger.Default.LogMode(gormLogLevel(cfg.LogLevel)),
+		NowFunc: func() time.Time {
+			return time.Now().UTC()
+		},
 	})
 
 	helper.PanicIfError(err)
@@ -20,10 +25,22 @@ func NewDB() *gorm.DB {
 	sqlDB, err := db.DB()
 	helper.PanicIfError(err)
 
-	sqlDB.SetMaxIdleConns(5)
-	sqlDB.SetMaxOpenConns(20)
-	sqlDB.SetConnMaxLifetime(60 * time.Minute)
-	sqlDB.SetConnMaxIdleTime(60 * time.Minute)
+	sqlDB.SetMaxIdleConns(cfg.DB.MaxIdleConns)
+	sqlDB.SetMaxOpenConns(cfg.DB.MaxOpenConns)
+	sqlDB.SetConnMaxLifetime(cfg.DB.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.DB.ConnMaxIdleTime)
 
 	return db
 }
+
+func gormLogLevel(level string) logger.LogLevel {
+	switch level {
+	case svcconfig.LogLevelSilent:
+		return logger.Silent
+	case svcconfig.LogLevelError:
+		return logger.Error
+	case svcconfig.LogLevelWarn:
+		return logger.Warn
+	}
+	return logger.Info
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/DevisArya/learn-microservices/svcconfig"
+)
+
+// Settings is the configuration of the user service: the settings every
+// service shares and the tunables of its outbox relay.
+type Settings struct {
+	svcconfig.Config `yaml:",inline"`
+
+	Outbox OutboxSettings `yaml:"outbox" toml:"outbox" env:"OUTBOX"`
+}
+
+// OutboxSettings is how often the outbox relay runs and how many events it
+// publishes per run. A zero interval turns the relay off.
+type OutboxSettings struct {
+	RelayInterval time.Duration `yaml:"relay_interval" toml:"relay_interval" env:"RELAY_INTERVAL"`
+	BatchSize     int           `yaml:"batch_size" toml:"batch_size" env:"BATCH_SIZE"`
+}
+
+// LoadSettings returns the settings of the user service, read from the USER_*
+// environment variables and the file named by USER_CONFIG_FILE over its
+// defaults.
+func LoadSettings() (*Settings, error) {
+	defaults := Settings{
+		Config: svcconfig.Defaults(),
+		Outbox: OutboxSettings{
+			RelayInterval: 5 * time.Second,
+			BatchSize:     100,
+		},
+	}
+	defaults.ListenAddr = ":50052"
+	//the password is not kept in code, set it through USER_DB_DSN or the config file
+	defaults.DB.DSN = "user_service@tcp(localhost:3306)/user_service?charset=utf8mb4&parseTime=True&loc=UTC"
+
+	return svcconfig.Load("USER", defaults)
+}
+
+// Validate reports every setting that the service can not run with.
+func (s *Settings) Validate() error {
+	errs := []error{s.Config.Validate()}
+
+	if s.Outbox.RelayInterval < 0 {
+		errs = append(errs, errors.New("outbox.relay_interval: must not be negative"))
+	}
+	if s.Outbox.BatchSize < 0 {
+		errs = append(errs, errors.New("outbox.batch_size: must not be negative"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -5,11 +5,10 @@ import (
 
 	"github.com/DevisArya/learn-microservices-protorepo/pb/pagination"
 	userpb "github.com/DevisArya/learn-microservices-protorepo/pb/user"
+	"github.com/DevisArya/learn-microservices/svckit/grpcserver"
 	"github.com/DevisArya/learn-microservices/user-service/internal/dto"
 	"github.com/DevisArya/learn-microservices/user-service/internal/entity"
 	"github.com/DevisArya/learn-microservices/user-service/internal/usecase"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type UserController interface {
@@ -38,7 +37,7 @@ func (controller *UserControllerImpl) CreateUser(ctx context.Context, req *userp
 	id, err := controller.userUC.Create(ctx, &userCreateReq, entity.RoleUser)
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpcserver.Error(err)
 	}
 	return &userpb.CreateUserResponse{
 		Id: &userpb.Id{
@@ -52,7 +51,7 @@ func (controller *UserControllerImpl) GetUser(ctx context.Context, req *userpb.I
 	user, err := controller.userUC.FindById(ctx, uint(req.GetId()))
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpcserver.Error(err)
 	}
 
 	return &userpb.GetUserResponse{
@@ -71,7 +70,7 @@ func (controller *UserControllerImpl) UpdatePasswordUser(ctx context.Context, re
 		Password: req.GetPassword(),
 	}
 	if err := controller.userUC.UpdatePassword(ctx, updatedData, uint(req.Id.GetId())); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpcserver.Error(err)
 	}
 
 	return &userpb.StatusResponse{
@@ -85,7 +84,7 @@ func (controller *UserControllerImpl) UpdateEmailUser(ctx context.Context, req *
 		Email: req.GetEmail(),
 	}
 	if err := controller.userUC.UpdateEmail(ctx, updatedData, uint(req.Id.GetId())); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpcserver.Error(err)
 	}
 
 	return &userpb.StatusResponse{
@@ -100,7 +99,7 @@ func (controller *UserControllerImpl) UpdateProfileUser(ctx context.Context, req
 	}
 
 	if err := controller.userUC.UpdateProfile(ctx, updatedData, uint(req.Id.GetId())); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpcserver.Error(err)
 	}
 
 	return &userpb.StatusResponse{
@@ -111,7 +110,7 @@ func (controller *UserControllerImpl) UpdateProfileUser(ctx context.Context, req
 func (controller *UserControllerImpl) Delete(ctx context.Context, req *userpb.Id) (*userpb.StatusResponse, error) {
 
 	if err := controller.userUC.Delete(ctx, uint(req.GetId())); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpcserver.Error(err)
 	}
 
 	return &userpb.StatusResponse{
@@ -122,10 +121,22 @@ func (controller *UserControllerImpl) Delete(ctx context.Context, req *userpb.Id
 // FindAll implements UserHandler
 func (controller *UserControllerImpl) GetUsers(ctx context.Context, req *userpb.GetUsersRequest) (*userpb.GetUsersResponse, error) {
 
-	res, paging, err := controller.userUC.FindAll(ctx, req.GetLimit(), req.GetPage())
+	var res *[]entity.User
+	var paging *dto.PaginationResponse
+	var err error
+
+	if req.Cursor != nil {
+		res, paging, err = controller.userUC.FindAllByCursor(ctx, &dto.CursorRequest{
+			Cursor:    req.GetCursor(),
+			Limit:     req.GetLimit(),
+			WithCount: req.GetWithCount(),
+		})
+	} else {
+		res, paging, err = controller.userUC.FindAll(ctx, req.GetLimit(), req.GetPage())
+	}
 
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpcserver.Error(err)
 	}
 
 	var users []*userpb.User
@@ -145,6 +156,8 @@ func (controller *UserControllerImpl) GetUsers(ctx context.Context, req *userpb.
 			Limit:       paging.Limit,
 			TotalRecord: paging.TotalRecord,
 			TotalPage:   paging.TotalPage,
+			NextCursor:  paging.NextCursor,
+			PrevCursor:  paging.PrevCursor,
 		}, Data: users,
 	}, nil
 }
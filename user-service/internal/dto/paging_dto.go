@@ -5,4 +5,22 @@ type PaginationResponse struct {
 	Limit       uint32
 	TotalRecord uint32
 	TotalPage   uint32
+	NextCursor  string
+	PrevCursor  string
+}
+
+type CursorRequest struct {
+	Cursor    string
+	Limit     uint32
+	WithCount bool
+}
+
+// Cursor is the decoded form of an opaque page token. It holds the sort key of
+// the row the next page starts after (or, when Prev is set, ends before).
+type Cursor struct {
+	SortBy    string `json:"s,omitempty"`
+	SortOrder string `json:"o,omitempty"`
+	Value     string `json:"v,omitempty"`
+	Id        uint   `json:"i"`
+	Prev      bool   `json:"p,omitempty"`
 }
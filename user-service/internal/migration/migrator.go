@@ -0,0 +1,29 @@
+// Package migration holds the schema migrations of the service, embedded in
+// the binary and run by the shared svckit migrator.
+package migration
+
+import (
+	"embed"
+	"io/fs"
+
+	migrationkit "github.com/DevisArya/learn-microservices/svckit/migration"
+	"gorm.io/gorm"
+)
+
+// Table keeps the applied versions. The database is shared with the other
+// services, so the table is named after this one.
+const Table = "user_schema_migrations"
+
+//go:embed sql/*.sql
+var files embed.FS
+
+// NewMigrator returns a migrator running the embedded migrations of the
+// service against DB.
+func NewMigrator(DB *gorm.DB) (*migrationkit.Migrator, error) {
+	sqlFiles, err := fs.Sub(files, "sql")
+	if err != nil {
+		return nil, err
+	}
+
+	return migrationkit.NewMigrator(DB, Table, sqlFiles)
+}
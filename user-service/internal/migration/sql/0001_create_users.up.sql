@@ -0,0 +1,10 @@
+CREATE TABLE users (
+	id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+	name VARCHAR(255) NOT NULL,
+	email VARCHAR(255) NOT NULL,
+	password VARCHAR(255) NOT NULL,
+	phone_number VARCHAR(20),
+	role ENUM('user', 'operator', 'super user'),
+	PRIMARY KEY (id),
+	UNIQUE INDEX idx_users_email (email)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
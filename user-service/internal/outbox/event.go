@@ -0,0 +1,24 @@
+package outbox
+
+import (
+	outboxkit "github.com/DevisArya/learn-microservices/svckit/outbox"
+)
+
+// Source marks the outbox events written by this service.
+const Source = "user-service"
+
+// Event types published by this service.
+const (
+	EventUserDeleted = "user.deleted"
+)
+
+type UserDeleted struct {
+	UserId uint   `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// NewEvent returns an outbox event of eventType written by this service about
+// the aggregate, with payload encoded as JSON.
+func NewEvent(eventType string, aggregateType string, aggregateId uint, payload any) (*outboxkit.Event, error) {
+	return outboxkit.NewEvent(Source, eventType, aggregateType, aggregateId, payload)
+}
@@ -3,30 +3,38 @@ package repository
 import (
 	"context"
 
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
+	"github.com/DevisArya/learn-microservices/user-service/internal/dto"
 	"github.com/DevisArya/learn-microservices/user-service/internal/entity"
 	"gorm.io/gorm"
 )
 
 type UserRepository interface {
-	Save(ctx context.Context, tx *gorm.DB, user *entity.User) (*uint, error)
-	Update(ctx context.Context, tx *gorm.DB, user *entity.User) error
-	Delete(ctx context.Context, tx *gorm.DB, userId uint) error
-	FindById(ctx context.Context, tx *gorm.DB, userId uint) (*entity.User, error)
-	FindByEmail(ctx context.Context, tx *gorm.DB, email string) (bool, error)
-	FindAll(ctx context.Context, tx *gorm.DB, limit, offset int) (*[]entity.User, *int64, error)
+	Save(ctx context.Context, user *entity.User) (*uint, error)
+	Update(ctx context.Context, user *entity.User) error
+	Delete(ctx context.Context, userId uint) error
+	FindById(ctx context.Context, userId uint) (*entity.User, error)
+	FindByEmail(ctx context.Context, email string) (bool, error)
+	FindAll(ctx context.Context, limit, offset int) (*[]entity.User, *int64, error)
+	FindAllByCursor(ctx context.Context, cursor *dto.Cursor, limit int) (*[]entity.User, bool, error)
+	Count(ctx context.Context) (*int64, error)
 }
 
 type UserRepositoryImpl struct {
+	DB *gorm.DB
 }
 
-func NewUserRepository() UserRepository {
-	return &UserRepositoryImpl{}
+func NewUserRepository(DB *gorm.DB) UserRepository {
+	return &UserRepositoryImpl{
+		DB: DB,
+	}
 }
 
 // Save implements UserRepository
-func (*UserRepositoryImpl) Save(ctx context.Context, tx *gorm.DB, user *entity.User) (*uint, error) {
+func (repository *UserRepositoryImpl) Save(ctx context.Context, user *entity.User) (*uint, error) {
+	tx := txmanager.DB(ctx, repository.DB)
 
-	if err := tx.WithContext(ctx).Create(user).Error; err != nil {
+	if err := tx.Create(user).Error; err != nil {
 		return nil, err
 	}
 
@@ -34,9 +42,10 @@ func (*UserRepositoryImpl) Save(ctx context.Context, tx *gorm.DB, user *entity.U
 }
 
 // Update implements UserRepository
-func (*UserRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, user *entity.User) error {
+func (repository *UserRepositoryImpl) Update(ctx context.Context, user *entity.User) error {
+	tx := txmanager.DB(ctx, repository.DB)
 
-	if err := tx.WithContext(ctx).Where("id = ?", user.Id).Updates(&user).Error; err != nil {
+	if err := tx.Where("id = ?", user.Id).Updates(&user).Error; err != nil {
 		return err
 	}
 
@@ -44,9 +53,10 @@ func (*UserRepositoryImpl) Update(ctx context.Context, tx *gorm.DB, user *entity
 }
 
 // Delete implements UserRepository
-func (*UserRepositoryImpl) Delete(ctx context.Context, tx *gorm.DB, userId uint) error {
+func (repository *UserRepositoryImpl) Delete(ctx context.Context, userId uint) error {
+	tx := txmanager.DB(ctx, repository.DB)
 
-	if err := tx.WithContext(ctx).Delete(&entity.User{}, userId).Error; err != nil {
+	if err := tx.Delete(&entity.User{}, userId).Error; err != nil {
 		return err
 	}
 
@@ -54,10 +64,12 @@ func (*UserRepositoryImpl) Delete(ctx context.Context, tx *gorm.DB, userId uint)
 }
 
 // FindById implements UserRepository
-func (*UserRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, userId uint) (*entity.User, error) {
+func (repository *UserRepositoryImpl) FindById(ctx context.Context, userId uint) (*entity.User, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
 	var user entity.User
 
-	if err := tx.WithContext(ctx).First(&user, userId).Error; err != nil {
+	if err := tx.First(&user, userId).Error; err != nil {
 		return nil, err
 	}
 
@@ -65,10 +77,12 @@ func (*UserRepositoryImpl) FindById(ctx context.Context, tx *gorm.DB, userId uin
 }
 
 // FindByEmail implements UserRepository
-func (*UserRepositoryImpl) FindByEmail(ctx context.Context, tx *gorm.DB, email string) (bool, error) {
+func (repository *UserRepositoryImpl) FindByEmail(ctx context.Context, email string) (bool, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
 	var user entity.User
 
-	if err := tx.WithContext(ctx).Where("email = ?", email).First(&user).Error; err != nil {
+	if err := tx.Where("email = ?", email).First(&user).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return true, nil
 		}
@@ -79,12 +93,13 @@ func (*UserRepositoryImpl) FindByEmail(ctx context.Context, tx *gorm.DB, email s
 }
 
 // FindAll implements UserRepository
-func (*UserRepositoryImpl) FindAll(ctx context.Context, tx *gorm.DB, limit, offset int) (*[]entity.User, *int64, error) {
+func (repository *UserRepositoryImpl) FindAll(ctx context.Context, limit, offset int) (*[]entity.User, *int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
 
 	var users []entity.User
 	var count int64
 
-	query := tx.WithContext(ctx).Model(&entity.User{}).Where("role = ?", "user")
+	query := tx.Model(&entity.User{}).Where("role = ?", "user")
 
 	if err := query.Count(&count).Error; err != nil {
 		return nil, nil, err
@@ -92,8 +107,63 @@ func (*UserRepositoryImpl) FindAll(ctx context.Context, tx *gorm.DB, limit, offs
 	if err := query.
 		Limit(limit).
 		Offset(offset).
+		Order("id ASC").
 		Find(&users).Error; err != nil {
 		return nil, nil, err
 	}
 	return &users, &count, nil
 }
+
+// FindAllByCursor implements UserRepository
+func (repository *UserRepositoryImpl) FindAllByCursor(ctx context.Context, cursor *dto.Cursor, limit int) (*[]entity.User, bool, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var users []entity.User
+
+	query := tx.Where("role = ?", "user")
+
+	//walking backwards flips the order, the page is reversed after the query
+	backward := cursor != nil && cursor.Prev
+	order := "id ASC"
+	if cursor != nil {
+		if backward {
+			query = query.Where("id < ?", cursor.Id)
+			order = "id DESC"
+		} else {
+			query = query.Where("id > ?", cursor.Id)
+		}
+	}
+
+	if err := query.
+		Order(order).
+		Limit(limit + 1).
+		Find(&users).Error; err != nil {
+		return nil, false, err
+	}
+
+	hasMore := len(users) > limit
+	if hasMore {
+		users = users[:limit]
+	}
+
+	if backward {
+		for i, j := 0, len(users)-1; i < j; i, j = i+1, j-1 {
+			users[i], users[j] = users[j], users[i]
+		}
+	}
+
+	return &users, hasMore, nil
+}
+
+// Count implements UserRepository
+func (repository *UserRepositoryImpl) Count(ctx context.Context) (*int64, error) {
+	tx := txmanager.DB(ctx, repository.DB)
+
+	var count int64
+
+	if err := tx.Model(&entity.User{}).Where("role = ?", "user").Count(&count).Error; err != nil {
+		return nil, err
+	}
+
+	return &count, nil
+}
@@ -2,15 +2,17 @@ package usecase
 
 import (
 	"context"
-	"errors"
 
+	"github.com/DevisArya/learn-microservices/svckit/apperror"
+	outboxkit "github.com/DevisArya/learn-microservices/svckit/outbox"
+	"github.com/DevisArya/learn-microservices/svckit/txmanager"
 	"github.com/DevisArya/learn-microservices/user-service/internal/dto"
 	"github.com/DevisArya/learn-microservices/user-service/internal/entity"
 	"github.com/DevisArya/learn-microservices/user-service/internal/helper"
+	"github.com/DevisArya/learn-microservices/user-service/internal/outbox"
 	"github.com/DevisArya/learn-microservices/user-service/internal/repository"
 	"github.com/DevisArya/learn-microservices/user-service/internal/utils"
 	"github.com/go-playground/validator/v10"
-	"gorm.io/gorm"
 )
 
 type UserUseCase interface {
@@ -21,19 +23,22 @@ type UserUseCase interface {
 	Delete(ctx context.Context, id uint) error
 	FindById(ctx context.Context, id uint) (*entity.User, error)
 	FindAll(ctx context.Context, limit, page uint32) (*[]entity.User, *dto.PaginationResponse, error)
+	FindAllByCursor(ctx context.Context, page *dto.CursorRequest) (*[]entity.User, *dto.PaginationResponse, error)
 }
 
 type UserUseCaseImpl struct {
-	UserRepository repository.UserRepository
-	DB             *gorm.DB
-	validate       *validator.Validate
+	UserRepository   repository.UserRepository
+	OutboxRepository outboxkit.Repository
+	TxManager        txmanager.Manager
+	validate         *validator.Validate
 }
 
-func NewUserUseCase(userRepository repository.UserRepository, DB *gorm.DB, validate *validator.Validate) UserUseCase {
+func NewUserUseCase(userRepository repository.UserRepository, outboxRepository outboxkit.Repository, txManager txmanager.Manager, validate *validator.Validate) UserUseCase {
 	return &UserUseCaseImpl{
-		UserRepository: userRepository,
-		DB:             DB,
-		validate:       validate,
+		UserRepository:   userRepository,
+		OutboxRepository: outboxRepository,
+		TxManager:        txManager,
+		validate:         validate,
 	}
 }
 
@@ -43,35 +48,35 @@ func (service *UserUseCaseImpl) Create(ctx context.Context, request *dto.UserCre
 		return nil, err
 	}
 
-	tx := service.DB.Begin()
-	defer helper.CommitOrRollback(tx)
-
-	//check used email
-	cekEmail, err := service.UserRepository.FindByEmail(ctx, tx, request.Email)
-	if err != nil {
-		return nil, err
-	}
-
-	if !cekEmail {
-		return nil, errors.New("email already use")
-	}
-
 	//hash password
 	hashedPassword, err := utils.HashPassword(request.Password)
 	if err != nil {
 		return nil, err
 	}
 
-	userData := entity.User{
-		Email:       request.Email,
-		Name:        request.Name,
-		Password:    hashedPassword,
-		PhoneNumber: request.PhoneNumbner,
-		Role:        role,
-	}
-
-	id, err := service.UserRepository.Save(ctx, tx, &userData)
-
+	var id *uint
+	err = service.TxManager.Do(ctx, func(ctx context.Context) error {
+		//check used email
+		cekEmail, err := service.UserRepository.FindByEmail(ctx, request.Email)
+		if err != nil {
+			return err
+		}
+
+		if !cekEmail {
+			return apperror.AlreadyExists("email already use")
+		}
+
+		userData := entity.User{
+			Email:       request.Email,
+			Name:        request.Name,
+			Password:    hashedPassword,
+			PhoneNumber: request.PhoneNumbner,
+			Role:        role,
+		}
+
+		id, err = service.UserRepository.Save(ctx, &userData)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -84,35 +89,34 @@ func (service *UserUseCaseImpl) UpdatePassword(ctx context.Context, request *dto
 		return err
 	}
 
-	tx := service.DB.Begin()
-	defer helper.CommitOrRollback(tx)
-
-	user, err := service.UserRepository.FindById(ctx, tx, id)
-	if err != nil {
-		return err
-	}
-
 	// hash new password
 	hashedPassword, err := utils.HashPassword(request.Password)
 	if err != nil {
 		return err
 	}
 
-	//validate new password same or not
-	if user.Password == hashedPassword {
-		return errors.New("new password must be different from the current password")
-	}
+	return service.TxManager.Do(ctx, func(ctx context.Context) error {
+		user, err := service.UserRepository.FindById(ctx, id)
+		if err != nil {
+			return err
+		}
 
-	userData := entity.User{
-		Id:       id,
-		Password: hashedPassword,
-	}
+		//validate new password same or not
+		if user.Password == hashedPassword {
+			return apperror.Violation("Password", "new password must be different from the current password")
+		}
 
-	if err := service.UserRepository.Update(ctx, tx, &userData); err != nil {
-		return err
-	}
+		userData := entity.User{
+			Id:       id,
+			Password: hashedPassword,
+		}
 
-	return nil
+		if err := service.UserRepository.Update(ctx, &userData); err != nil {
+			return err
+		}
+
+		return nil
+	})
 }
 
 // UpdateEmail implements UserUseCase
@@ -121,25 +125,24 @@ func (service *UserUseCaseImpl) UpdateEmail(ctx context.Context, request *dto.Us
 		return err
 	}
 
-	tx := service.DB.Begin()
-	defer helper.CommitOrRollback(tx)
+	return service.TxManager.Do(ctx, func(ctx context.Context) error {
+		//check used email
+		_, err := service.UserRepository.FindByEmail(ctx, request.Email)
+		if err != nil {
+			return err
+		}
 
-	//check used email
-	_, err := service.UserRepository.FindByEmail(ctx, tx, request.Email)
-	if err != nil {
-		return err
-	}
+		userData := entity.User{
+			Id:    id,
+			Email: request.Email,
+		}
 
-	userData := entity.User{
-		Id:    id,
-		Email: request.Email,
-	}
+		if err := service.UserRepository.Update(ctx, &userData); err != nil {
+			return err
+		}
 
-	if err := service.UserRepository.Update(ctx, tx, &userData); err != nil {
-		return err
-	}
-
-	return nil
+		return nil
+	})
 }
 
 // UpdateProfile implements UserUseCase
@@ -148,16 +151,13 @@ func (service *UserUseCaseImpl) UpdateProfile(ctx context.Context, request *dto.
 		return err
 	}
 
-	tx := service.DB.Begin()
-	defer helper.CommitOrRollback(tx)
-
 	userData := entity.User{
 		Id:          id,
 		Name:        request.Name,
 		PhoneNumber: request.PhoneNumbner,
 	}
 
-	if err := service.UserRepository.Update(ctx, tx, &userData); err != nil {
+	if err := service.UserRepository.Update(ctx, &userData); err != nil {
 		return err
 	}
 
@@ -166,26 +166,35 @@ func (service *UserUseCaseImpl) UpdateProfile(ctx context.Context, request *dto.
 
 // Delete implements UserUseCase
 func (service *UserUseCaseImpl) Delete(ctx context.Context, id uint) error {
-	tx := service.DB.Begin()
-	defer helper.CommitOrRollback(tx)
-
-	if _, err := service.UserRepository.FindById(ctx, tx, id); err != nil {
-		return err
-	}
-
-	if err := service.UserRepository.Delete(ctx, tx, id); err != nil {
-		return err
-	}
-
-	return nil
+	return service.TxManager.Do(ctx, func(ctx context.Context) error {
+		user, err := service.UserRepository.FindById(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := service.UserRepository.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		event, err := outbox.NewEvent(outbox.EventUserDeleted, "user", id, outbox.UserDeleted{
+			UserId: id,
+			Email:  user.Email,
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err := service.OutboxRepository.Save(ctx, event); err != nil {
+			return err
+		}
+
+		return nil
+	})
 }
 
 // FindById implements UserUseCase
 func (service *UserUseCaseImpl) FindById(ctx context.Context, id uint) (*entity.User, error) {
-	tx := service.DB.Begin()
-	defer helper.CommitOrRollback(tx)
-
-	user, err := service.UserRepository.FindById(ctx, tx, id)
+	user, err := service.UserRepository.FindById(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -195,12 +204,15 @@ func (service *UserUseCaseImpl) FindById(ctx context.Context, id uint) (*entity.
 
 // FindAll implements UserUseCase
 func (service *UserUseCaseImpl) FindAll(ctx context.Context, limit, page uint32) (*[]entity.User, *dto.PaginationResponse, error) {
-	tx := service.DB.Begin()
-	defer helper.CommitOrRollback(tx)
-
 	offset := (page - 1) * limit
 
-	users, totalRecord, err := service.UserRepository.FindAll(ctx, tx, int(limit), int(offset))
+	var users *[]entity.User
+	var totalRecord *int64
+	err := service.TxManager.ReadOnly(ctx, func(ctx context.Context) error {
+		var err error
+		users, totalRecord, err = service.UserRepository.FindAll(ctx, int(limit), int(offset))
+		return err
+	})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -214,3 +226,58 @@ func (service *UserUseCaseImpl) FindAll(ctx context.Context, limit, page uint32)
 		TotalPage:   totalPage,
 	}, nil
 }
+
+// FindAllByCursor implements UserUseCase
+func (service *UserUseCaseImpl) FindAllByCursor(ctx context.Context, page *dto.CursorRequest) (*[]entity.User, *dto.PaginationResponse, error) {
+	limit := page.Limit
+	if limit < 1 {
+		limit = 10
+	}
+
+	var cursor *dto.Cursor
+	if page.Cursor != "" {
+		decoded, err := helper.DecodeCursor(page.Cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+		cursor = decoded
+	}
+
+	var users *[]entity.User
+	var hasMore bool
+	var totalRecord *int64
+	err := service.TxManager.ReadOnly(ctx, func(ctx context.Context) error {
+		var err error
+		users, hasMore, err = service.UserRepository.FindAllByCursor(ctx, cursor, int(limit))
+		if err != nil || !page.WithCount {
+			return err
+		}
+
+		totalRecord, err = service.UserRepository.Count(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	paging := dto.PaginationResponse{
+		Limit: limit,
+	}
+
+	if n := len(*users); n > 0 {
+		backward := cursor != nil && cursor.Prev
+
+		if hasMore || backward {
+			paging.NextCursor = helper.EncodeCursor(&dto.Cursor{Id: (*users)[n-1].Id})
+		}
+		if (hasMore && backward) || (cursor != nil && !backward) {
+			paging.PrevCursor = helper.EncodeCursor(&dto.Cursor{Id: (*users)[0].Id, Prev: true})
+		}
+	}
+
+	if page.WithCount {
+		paging.TotalRecord = uint32(*totalRecord)
+	}
+
+	return users, &paging, nil
+}